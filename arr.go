@@ -5,11 +5,15 @@ import (
 )
 
 // ArrHelpers provides array helper functions similar to Laravel's Arr class.
-type ArrHelpers struct{}
+type ArrHelpers struct {
+	lenient bool // Typed getters coerce compatible values
+}
 
 var Arr = ArrHelpers{}
 
 // Get retrieves a value from a nested map using dot notation.
+// Numeric segments index into lists ("users.0.name"), "{first}" and "{last}" select the
+// first or last element, and a "*" segment returns a []any of every match ("users.*.email").
 func (ArrHelpers) Get(data map[string]any, key string, defaultValue ...any) any {
 	if key == "" {
 		return data
@@ -20,64 +24,33 @@ func (ArrHelpers) Get(data map[string]any, key string, defaultValue ...any) any
 		return val
 	}
 
-	// Check for dot notation
-	if !strings.Contains(key, ".") {
-		if len(defaultValue) > 0 {
-			return defaultValue[0]
-		}
-		return nil
+	if val, ok := pathGet(data, splitPath(key)); ok {
+		return val
 	}
-
-	keys := strings.Split(key, ".")
-	current := any(data)
-
-	for _, k := range keys {
-		switch v := current.(type) {
-		case map[string]any:
-			if val, ok := v[k]; ok {
-				current = val
-			} else {
-				if len(defaultValue) > 0 {
-					return defaultValue[0]
-				}
-				return nil
-			}
-		default:
-			if len(defaultValue) > 0 {
-				return defaultValue[0]
-			}
-			return nil
-		}
+	if len(defaultValue) > 0 {
+		return defaultValue[0]
 	}
-
-	return current
+	return nil
 }
 
 // Set sets a value in a nested map using dot notation.
+// A missing intermediate is created as a list for the segment "0" and as a map otherwise.
+// Lists grow only by setting the index equal to their length, and a "*" segment sets the
+// value below every existing element.
 func (ArrHelpers) Set(data map[string]any, key string, value any) map[string]any {
 	if key == "" {
 		return data
 	}
+	pathWrite(data, true, splitPath(key), value, writeSet)
+	return data
+}
 
-	keys := strings.Split(key, ".")
-	current := data
-
-	for i, k := range keys {
-		if i == len(keys)-1 {
-			current[k] = value
-		} else {
-			if _, ok := current[k]; !ok {
-				current[k] = make(map[string]any)
-			}
-			if next, ok := current[k].(map[string]any); ok {
-				current = next
-			} else {
-				current[k] = make(map[string]any)
-				current = current[k].(map[string]any)
-			}
-		}
+// Fill sets a value using dot notation only where it is missing, like Laravel's data_fill.
+func (ArrHelpers) Fill(data map[string]any, key string, value any) map[string]any {
+	if key == "" {
+		return data
 	}
-
+	pathWrite(data, true, splitPath(key), value, writeFill)
 	return data
 }
 
@@ -104,25 +77,11 @@ func (ArrHelpers) HasAny(data map[string]any, keys ...string) bool {
 // Forget removes keys from a map using dot notation.
 func (ArrHelpers) Forget(data map[string]any, keys ...string) map[string]any {
 	for _, key := range keys {
-		if !strings.Contains(key, ".") {
+		if _, ok := data[key]; ok || !strings.Contains(key, ".") {
 			delete(data, key)
 			continue
 		}
-
-		parts := strings.Split(key, ".")
-		current := data
-
-		for i, part := range parts {
-			if i == len(parts)-1 {
-				delete(current, part)
-			} else {
-				if next, ok := current[part].(map[string]any); ok {
-					current = next
-				} else {
-					break
-				}
-			}
-		}
+		pathForget(data, splitPath(key))
 	}
 	return data
 }
@@ -154,14 +113,11 @@ func (ArrHelpers) Dot(data map[string]any, prepend ...string) map[string]any {
 }
 
 // Undot expands dot notation keys into a nested map.
+// Keys are applied in sorted order, and a level whose keys are exactly 0 to n-1 becomes
+// a list; any other key keeps it a map. Undot(Dot(data)) therefore turns maps keyed
+// exactly 0 to n-1 into lists, and splits keys that contain a dot.
 func (ArrHelpers) Undot(data map[string]any) map[string]any {
-	result := make(map[string]any)
-
-	for key, value := range data {
-		Arr.Set(result, key, value)
-	}
-
-	return result
+	return Arr.UndotWith(data, DotOptions{FlattenLists: true})
 }
 
 // Only returns only the specified keys.
@@ -313,15 +269,6 @@ func (ArrHelpers) IsList(items []any) bool {
 	return true // Go slices are always lists
 }
 
-// Query builds a query string from a map.
-func (ArrHelpers) Query(data map[string]string) string {
-	parts := make([]string, 0, len(data))
-	for k, v := range data {
-		parts = append(parts, k+"="+v)
-	}
-	return strings.Join(parts, "&")
-}
-
 // Accessible returns true (Go slices are always accessible).
 func (ArrHelpers) Accessible(value any) bool {
 	return true
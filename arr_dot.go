@@ -0,0 +1,196 @@
+package collections
+
+import (
+	"strconv"
+	"strings"
+)
+
+// DotOptions configures Arr.DotWith, Arr.UndotWith and Arr.UndotMapWith.
+// Using the same options with DotWith and UndotMapWith round-trips the data, except that
+// empty maps and lists are dropped unless KeepEmpty is set, keys containing the separator
+// are split unless Escape is set, and with FlattenLists a map keyed exactly 0 to n-1
+// comes back as a list.
+type DotOptions struct {
+	// Separator joins key segments. Defaults to ".".
+	Separator string
+	// FlattenLists flattens []any values into index segments ("items.0.id") and makes
+	// UndotWith rebuild maps keyed 0 to n-1 as lists.
+	FlattenLists bool
+	// Escape prefixes literal separators and backslashes in keys with a backslash.
+	Escape bool
+	// MaxDepth limits the number of segments in a key; deeper containers are kept as values.
+	// Zero means unlimited.
+	MaxDepth int
+	// KeepEmpty keeps empty maps (and empty lists when flattening lists) as values
+	// instead of dropping them.
+	KeepEmpty bool
+}
+
+// separator returns the configured separator or the default.
+func (o DotOptions) separator() string {
+	if o.Separator == "" {
+		return "."
+	}
+	return o.Separator
+}
+
+// join appends a segment to a flattened key, escaping it if configured.
+func (o DotOptions) join(prefix string, depth int, seg string) string {
+	sep := o.separator()
+	if o.Escape {
+		seg = strings.ReplaceAll(seg, `\`, `\\`)
+		seg = strings.ReplaceAll(seg, sep, `\`+sep)
+	}
+	if depth == 0 {
+		return seg
+	}
+	return prefix + sep + seg
+}
+
+// split splits a flattened key into segments, honouring escapes if configured.
+func (o DotOptions) split(key string) []string {
+	sep := o.separator()
+	if !o.Escape {
+		return strings.Split(key, sep)
+	}
+	var (
+		segments []string
+		current  strings.Builder
+	)
+	for i := 0; i < len(key); {
+		switch {
+		case key[i] == '\\' && strings.HasPrefix(key[i+1:], sep):
+			current.WriteString(sep)
+			i += 1 + len(sep)
+		case key[i] == '\\' && i+1 < len(key):
+			current.WriteByte(key[i+1])
+			i += 2
+		case strings.HasPrefix(key[i:], sep):
+			segments = append(segments, current.String())
+			current.Reset()
+			i += len(sep)
+		default:
+			current.WriteByte(key[i])
+			i++
+		}
+	}
+	return append(segments, current.String())
+}
+
+// DotWith flattens a nested map into a MapCollection of separator-joined keys.
+// Keys are ordered by map key at each level and by index within lists, so the output
+// is deterministic.
+func (ArrHelpers) DotWith(data map[string]any, options DotOptions) *MapCollection[string, any] {
+	result := NewMap[string, any](nil)
+	dotWith(result, data, "", 0, options)
+	return result
+}
+
+// UndotWith expands a flattened map produced by DotWith back into nested maps and lists.
+// Keys are applied in sorted order, so a longer key replaces a scalar stored at its prefix.
+func (ArrHelpers) UndotWith(data map[string]any, options DotOptions) map[string]any {
+	root := &undotNode{children: make(map[string]any)}
+	for _, key := range sortedKeys(data) {
+		segments := options.split(key)
+		node := root
+		for _, seg := range segments[:len(segments)-1] {
+			child, ok := node.children[seg].(*undotNode)
+			if !ok {
+				child = &undotNode{children: make(map[string]any)}
+				node.children[seg] = child
+			}
+			node = child
+		}
+		node.children[segments[len(segments)-1]] = data[key]
+	}
+	return root.toMap(options.FlattenLists)
+}
+
+// UndotMapWith is UndotWith for the MapCollection returned by DotWith.
+func (ArrHelpers) UndotMapWith(data *MapCollection[string, any], options DotOptions) map[string]any {
+	if data == nil {
+		return make(map[string]any)
+	}
+	return Arr.UndotWith(data.items, options)
+}
+
+// dotWith writes the leaves of a map or list into result.
+func dotWith(result *MapCollection[string, any], container any, prefix string, depth int, options DotOptions) {
+	keys, children := dotChildren(container)
+	for i, seg := range keys {
+		key := options.join(prefix, depth, seg)
+		child := children[i]
+		if !isDotContainer(child, options) || (options.MaxDepth > 0 && depth+1 >= options.MaxDepth) {
+			result.Put(key, child)
+			continue
+		}
+		if childKeys, _ := dotChildren(child); len(childKeys) == 0 {
+			if options.KeepEmpty {
+				result.Put(key, child)
+			}
+			continue
+		}
+		dotWith(result, child, key, depth+1, options)
+	}
+}
+
+// dotChildren returns the segments and values of a map or list in output order.
+func dotChildren(container any) ([]string, []any) {
+	switch v := container.(type) {
+	case map[string]any:
+		keys := sortedKeys(v)
+		values := make([]any, len(keys))
+		for i, k := range keys {
+			values[i] = v[k]
+		}
+		return keys, values
+	case []any:
+		return indexKeys(len(v)), v
+	}
+	return nil, nil
+}
+
+// isDotContainer determines if DotWith descends into a value.
+func isDotContainer(value any, options DotOptions) bool {
+	switch value.(type) {
+	case map[string]any:
+		return true
+	case []any:
+		return options.FlattenLists
+	}
+	return false
+}
+
+// undotNode is an intermediate map built by UndotWith, kept apart from map values in the input.
+type undotNode struct {
+	children map[string]any
+}
+
+// toMap converts the node and its descendants into nested maps.
+func (n *undotNode) toMap(lists bool) map[string]any {
+	result := make(map[string]any, len(n.children))
+	for k, child := range n.children {
+		if node, ok := child.(*undotNode); ok {
+			child = node.toValue(lists)
+		}
+		result[k] = child
+	}
+	return result
+}
+
+// toValue converts the node into a list if lists are enabled and its keys are exactly 0 to n-1.
+func (n *undotNode) toValue(lists bool) any {
+	m := n.toMap(lists)
+	if !lists {
+		return m
+	}
+	list := make([]any, len(m))
+	for k, v := range m {
+		i, ok := parseIndex(k)
+		if !ok || i >= len(m) || strconv.Itoa(i) != k {
+			return m
+		}
+		list[i] = v
+	}
+	return list
+}
@@ -0,0 +1,140 @@
+package collections_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/qiuapeng921/collections"
+)
+
+func TestArrDotWithDefaults(t *testing.T) {
+	flat := collections.Arr.DotWith(map[string]any{
+		"app":   map[string]any{"name": "demo", "version": "1.2"},
+		"items": []any{map[string]any{"id": 1}},
+		"meta":  map[string]any{},
+	}, collections.DotOptions{})
+	if !reflect.DeepEqual(flat.Keys().All(), []string{"app.name", "app.version", "items"}) {
+		t.Errorf("DotWith keys failed: %v", flat.Keys().All())
+	}
+	if _, ok := flat.Get("items").([]any); !ok {
+		t.Error("Lists should be kept as values by default")
+	}
+}
+
+func TestArrDotWithSeparatorAndEscape(t *testing.T) {
+	flat := collections.Arr.DotWith(map[string]any{
+		"app":         map[string]any{"name": "demo"},
+		"example.com": map[string]any{"a/b": true},
+	}, collections.DotOptions{Separator: "/", Escape: true})
+	if !reflect.DeepEqual(flat.Keys().All(), []string{"app/name", `example.com/a\/b`}) {
+		t.Errorf("DotWith keys failed: %v", flat.Keys().All())
+	}
+}
+
+func TestArrDotWithFlattenLists(t *testing.T) {
+	flat := collections.Arr.DotWith(map[string]any{
+		"items": []any{
+			map[string]any{"id": 1, "tags": []any{"a", "b"}},
+			map[string]any{"id": 2, "tags": []any{}},
+		},
+		"meta": map[string]any{},
+	}, collections.DotOptions{FlattenLists: true, KeepEmpty: true})
+	expected := []string{"items.0.id", "items.0.tags.0", "items.0.tags.1", "items.1.id", "items.1.tags", "meta"}
+	if !reflect.DeepEqual(flat.Keys().All(), expected) {
+		t.Errorf("DotWith keys failed: %v", flat.Keys().All())
+	}
+	if flat.Get("items.0.tags.1") != "b" {
+		t.Error("DotWith list value failed")
+	}
+}
+
+func TestArrDotWithMaxDepth(t *testing.T) {
+	data := map[string]any{
+		"app":   map[string]any{"name": "demo"},
+		"items": []any{map[string]any{"id": 1}},
+	}
+	flat := collections.Arr.DotWith(data, collections.DotOptions{MaxDepth: 1})
+	if _, ok := flat.Get("app").(map[string]any); !ok || flat.Count() != 2 {
+		t.Errorf("MaxDepth 1 should keep deeper maps as values, got %v", flat.Keys().All())
+	}
+
+	flat = collections.Arr.DotWith(data, collections.DotOptions{MaxDepth: 2, FlattenLists: true})
+	if _, ok := flat.Get("items.0").(map[string]any); !ok {
+		t.Errorf("MaxDepth 2 failed: %v", flat.Keys().All())
+	}
+}
+
+func TestArrUndotWithRoundTrip(t *testing.T) {
+	options := collections.DotOptions{FlattenLists: true, Escape: true, KeepEmpty: true}
+	data := map[string]any{
+		"app":   map[string]any{"name": "demo"},
+		"items": []any{map[string]any{"id": 1, "tags": []any{}}},
+		"meta":  map[string]any{},
+		"a.b":   map[string]any{`c\d`: 1},
+	}
+
+	restored := collections.Arr.UndotMapWith(collections.Arr.DotWith(data, options), options)
+	if !reflect.DeepEqual(restored, data) {
+		t.Errorf("Round trip failed: %v", restored)
+	}
+}
+
+func TestArrUndotWithLists(t *testing.T) {
+	flat := map[string]any{"items.0.id": 1, "items.1.id": 2, "codes.0": "x", "codes.2": "z"}
+
+	withLists := collections.Arr.UndotWith(flat, collections.DotOptions{FlattenLists: true})
+	if !reflect.DeepEqual(withLists["items"], []any{map[string]any{"id": 1}, map[string]any{"id": 2}}) {
+		t.Errorf("UndotWith lists failed: %v", withLists["items"])
+	}
+	if _, ok := withLists["codes"].(map[string]any); !ok {
+		t.Error("Sparse indexes should stay a map")
+	}
+
+	withoutLists := collections.Arr.UndotWith(flat, collections.DotOptions{})
+	if _, ok := withoutLists["items"].(map[string]any); !ok {
+		t.Error("UndotWith without FlattenLists should build maps")
+	}
+}
+
+func TestArrUndotMapWithNil(t *testing.T) {
+	if restored := collections.Arr.UndotMapWith(nil, collections.DotOptions{}); restored == nil || len(restored) != 0 {
+		t.Errorf("UndotMapWith nil failed: %v", restored)
+	}
+}
+
+func TestArrUndotWithNumericKeyedMap(t *testing.T) {
+	data := map[string]any{"codes": map[string]any{"0": "zero", "1": "one"}}
+
+	restored := collections.Arr.UndotMapWith(collections.Arr.DotWith(data, collections.DotOptions{}), collections.DotOptions{})
+	if !reflect.DeepEqual(restored, data) {
+		t.Errorf("Numeric-keyed map without FlattenLists should round trip: %v", restored)
+	}
+
+	lists := collections.DotOptions{FlattenLists: true}
+	restored = collections.Arr.UndotMapWith(collections.Arr.DotWith(data, lists), lists)
+	if !reflect.DeepEqual(restored["codes"], []any{"zero", "one"}) {
+		t.Errorf("Numeric-keyed map with FlattenLists should come back as a list: %v", restored)
+	}
+}
+
+func TestArrUndotWithEmptyMap(t *testing.T) {
+	data := map[string]any{"meta": map[string]any{}, "name": "demo"}
+
+	restored := collections.Arr.UndotMapWith(collections.Arr.DotWith(data, collections.DotOptions{}), collections.DotOptions{})
+	if _, ok := restored["meta"]; ok {
+		t.Errorf("Empty maps should be dropped without KeepEmpty: %v", restored)
+	}
+
+	keep := collections.DotOptions{KeepEmpty: true}
+	if restored := collections.Arr.UndotMapWith(collections.Arr.DotWith(data, keep), keep); !reflect.DeepEqual(restored, data) {
+		t.Errorf("Empty maps should round trip with KeepEmpty: %v", restored)
+	}
+}
+
+func TestArrUndotDot(t *testing.T) {
+	data := map[string]any{"codes": map[string]any{"0": "zero"}, "meta": map[string]any{}}
+	want := map[string]any{"codes": []any{"zero"}, "meta": map[string]any{}}
+	if restored := collections.Arr.Undot(collections.Arr.Dot(data)); !reflect.DeepEqual(restored, want) {
+		t.Errorf("Undot(Dot) should keep empty maps and turn 0-keyed maps into lists: %v", restored)
+	}
+}
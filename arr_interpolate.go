@@ -0,0 +1,129 @@
+package collections
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Formatter formats a placeholder value for Arr.Interpolate.
+type Formatter func(value any) (string, error)
+
+// InterpolateOptions configures Arr.Interpolate.
+type InterpolateOptions struct {
+	// Open and Close delimit placeholders. They default to "{" and "}".
+	Open  string
+	Close string
+	// Strict makes missing paths without a default and unterminated placeholders errors.
+	// Otherwise a missing path renders as an empty string and an unterminated placeholder
+	// is kept as text.
+	Strict bool
+	// Formatters adds named formatters, used as "{path:name}". They take precedence over
+	// the built-in upper, lower and json formatters.
+	Formatters map[string]Formatter
+}
+
+// defaultFormatters are the named formatters available to every template.
+var defaultFormatters = map[string]Formatter{
+	"upper": func(value any) (string, error) {
+		return strings.ToUpper(queryString(value)), nil
+	},
+	"lower": func(value any) (string, error) {
+		return strings.ToLower(queryString(value)), nil
+	},
+	"json": func(value any) (string, error) {
+		b, err := json.Marshal(value)
+		return string(b), err
+	},
+}
+
+// Interpolate replaces the placeholders of a template with values read from data using dot
+// notation, so "Hello {user.name}" renders the "user.name" value.
+//
+// A placeholder has the form "{path:format|default}", where both parts are optional. The
+// default is used as-is when the path is missing or nil. The format is either a printf verb
+// such as "%.2f" or the name of a formatter. A backslash before the open delimiter escapes it.
+// Values are formatted like Arr.Query values: numbers without trailing zeros and times by their
+// String method. An empty path or an unknown formatter is always an error.
+func (ArrHelpers) Interpolate(template string, data map[string]any, options ...InterpolateOptions) (string, error) {
+	var opts InterpolateOptions
+	if len(options) > 0 {
+		opts = options[0]
+	}
+	if opts.Open == "" {
+		opts.Open = "{"
+	}
+	if opts.Close == "" {
+		opts.Close = "}"
+	}
+
+	var b strings.Builder
+	rest := template
+	for {
+		start := strings.Index(rest, opts.Open)
+		if start < 0 {
+			b.WriteString(rest)
+			return b.String(), nil
+		}
+		if start > 0 && rest[start-1] == '\\' {
+			b.WriteString(rest[:start-1])
+			b.WriteString(opts.Open)
+			rest = rest[start+len(opts.Open):]
+			continue
+		}
+		b.WriteString(rest[:start])
+		rest = rest[start+len(opts.Open):]
+
+		end := strings.Index(rest, opts.Close)
+		if end < 0 {
+			if opts.Strict {
+				return "", &InvalidArgumentException{Message: fmt.Sprintf("unterminated placeholder %q", opts.Open+rest)}
+			}
+			b.WriteString(opts.Open)
+			b.WriteString(rest)
+			return b.String(), nil
+		}
+		rendered, err := renderPlaceholder(rest[:end], data, opts)
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(rendered)
+		rest = rest[end+len(opts.Close):]
+	}
+}
+
+// renderPlaceholder renders the body of one placeholder.
+func renderPlaceholder(body string, data map[string]any, opts InterpolateOptions) (string, error) {
+	spec, fallback, hasDefault := strings.Cut(body, "|")
+	path, format, _ := strings.Cut(spec, ":")
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return "", &InvalidArgumentException{Message: fmt.Sprintf("empty path in placeholder %q", body)}
+	}
+
+	value, exists := lookupAny(data, path)
+	if !exists || value == nil {
+		if hasDefault {
+			return fallback, nil
+		}
+		if opts.Strict {
+			return "", &ItemNotFoundException{Message: fmt.Sprintf("path %q not found", path)}
+		}
+		return "", nil
+	}
+
+	switch {
+	case format == "":
+		return queryString(value), nil
+	case strings.Contains(format, "%"):
+		return fmt.Sprintf(format, value), nil
+	}
+	formatter, ok := opts.Formatters[format]
+	if !ok {
+		formatter, ok = defaultFormatters[format]
+	}
+	if !ok {
+		return "", &InvalidArgumentException{Message: fmt.Sprintf("unknown formatter %q in placeholder %q", format, body)}
+	}
+	return formatter(value)
+}
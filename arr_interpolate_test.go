@@ -0,0 +1,111 @@
+package collections_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/qiuapeng921/collections"
+)
+
+func TestArrInterpolate(t *testing.T) {
+	data := map[string]any{
+		"user":  map[string]any{"name": "Ann"},
+		"order": map[string]any{"id": 1042.0, "eta": "Monday"},
+	}
+	result, err := collections.Arr.Interpolate("Hello {user.name}, your order {order.id} ships {order.eta}", data)
+	if err != nil || result != "Hello Ann, your order 1042 ships Monday" {
+		t.Errorf("Interpolate failed: %q, %v", result, err)
+	}
+}
+
+func TestArrInterpolateDefaults(t *testing.T) {
+	data := map[string]any{"user": map[string]any{"nick": nil}}
+	result, err := collections.Arr.Interpolate("Hi {user.nick|guest}, {user.email|no email}", data)
+	if err != nil || result != "Hi guest, no email" {
+		t.Errorf("Interpolate defaults failed: %q, %v", result, err)
+	}
+}
+
+func TestArrInterpolateFormatters(t *testing.T) {
+	data := map[string]any{"name": "Ann", "total": 19.5, "tags": []any{"gift"}}
+	cases := map[string]string{
+		"Total: {total:%.2f}":  "Total: 19.50",
+		"{name:upper}":         "ANN",
+		"{tags:json}":          `["gift"]`,
+		"{ name }":             "Ann",
+		"Missing: [{missing}]": "Missing: []",
+	}
+	for template, expected := range cases {
+		result, err := collections.Arr.Interpolate(template, data)
+		if err != nil || result != expected {
+			t.Errorf("Interpolate(%q) = %q, %v, want %q", template, result, err, expected)
+		}
+	}
+}
+
+func TestArrInterpolateLiterals(t *testing.T) {
+	data := map[string]any{"name": "Ann"}
+	cases := map[string]string{
+		`Literal \{name} and {name}`: "Literal {name} and Ann",
+		"Unterminated {name":         "Unterminated {name",
+	}
+	for template, expected := range cases {
+		result, err := collections.Arr.Interpolate(template, data)
+		if err != nil || result != expected {
+			t.Errorf("Interpolate(%q) = %q, %v, want %q", template, result, err, expected)
+		}
+	}
+}
+
+func TestArrInterpolateOptions(t *testing.T) {
+	opts := collections.InterpolateOptions{
+		Open:  "${",
+		Close: "}",
+		Formatters: map[string]collections.Formatter{
+			"money": func(value any) (string, error) {
+				return fmt.Sprintf("$%.2f", value), nil
+			},
+		},
+	}
+	data := map[string]any{"name": "Ann", "total": 19.5}
+	result, err := collections.Arr.Interpolate("{plain} ${name} paid ${total:money}", data, opts)
+	if err != nil || result != "{plain} Ann paid $19.50" {
+		t.Errorf("custom options failed: %q, %v", result, err)
+	}
+}
+
+func TestArrInterpolateUnknownFormatter(t *testing.T) {
+	var invalid *collections.InvalidArgumentException
+	if _, err := collections.Arr.Interpolate("{name:shout}", map[string]any{"name": "Ann"}); !errors.As(err, &invalid) {
+		t.Errorf("an unknown formatter should fail: %v", err)
+	}
+}
+
+func TestArrInterpolateStrict(t *testing.T) {
+	data := map[string]any{"name": "Ann"}
+	strict := collections.InterpolateOptions{Strict: true}
+
+	var notFound *collections.ItemNotFoundException
+	if _, err := collections.Arr.Interpolate("Hi {email}", data, strict); !errors.As(err, &notFound) {
+		t.Errorf("strict mode should fail on missing paths: %v", err)
+	}
+	if result, err := collections.Arr.Interpolate("Hi {email|there}", data, strict); err != nil || result != "Hi there" {
+		t.Errorf("strict mode should use defaults: %q, %v", result, err)
+	}
+
+	var invalid *collections.InvalidArgumentException
+	if _, err := collections.Arr.Interpolate("Hi {name", data, strict); !errors.As(err, &invalid) {
+		t.Errorf("strict mode should fail on unterminated placeholders: %v", err)
+	}
+}
+
+func TestArrInterpolateEmptyPath(t *testing.T) {
+	data := map[string]any{"secret": "s3cr3t"}
+	var invalid *collections.InvalidArgumentException
+	for _, template := range []string{"{}", "{ :upper}", "{|x}"} {
+		if result, err := collections.Arr.Interpolate(template, data); !errors.As(err, &invalid) {
+			t.Errorf("Interpolate(%q) should reject an empty path: %q, %v", template, result, err)
+		}
+	}
+}
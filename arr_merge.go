@@ -0,0 +1,107 @@
+package collections
+
+// ArrMergeStrategy configures how Arr.MergeWith combines nested maps.
+type ArrMergeStrategy struct {
+	// Lists controls how []any values present on both sides are combined.
+	Lists ListMergeMode
+	// NilDeletes removes keys whose value in a later map is nil instead of setting them to nil.
+	NilDeletes bool
+	// InPlace merges into the first map instead of a new one. The first map's nested maps and
+	// lists are modified; the other maps are never mutated.
+	InPlace bool
+}
+
+// MergeRecursive merges nested maps from left to right, like PHP's array_merge_recursive.
+// Nested maps are merged key by key, lists are appended and other values are replaced.
+// The inputs are not mutated.
+func (a ArrHelpers) MergeRecursive(maps ...map[string]any) map[string]any {
+	return a.MergeWith(ArrMergeStrategy{Lists: ListAppend}, maps...)
+}
+
+// ReplaceRecursive replaces values from left to right, like PHP's array_replace_recursive.
+// Nested maps are merged key by key, lists are merged by index and other values are replaced.
+// The inputs are not mutated.
+func (a ArrHelpers) ReplaceRecursive(maps ...map[string]any) map[string]any {
+	return a.MergeWith(ArrMergeStrategy{Lists: ListMergeByIndex}, maps...)
+}
+
+// MergeWith merges nested maps from left to right using the given strategy.
+// Unless strategy.InPlace is set, the result shares no maps or lists with the inputs.
+func (ArrHelpers) MergeWith(strategy ArrMergeStrategy, maps ...map[string]any) map[string]any {
+	result := make(map[string]any)
+	if strategy.InPlace && len(maps) > 0 && maps[0] != nil {
+		result, maps = maps[0], maps[1:]
+	}
+	for _, m := range maps {
+		mergeMapInto(result, m, strategy)
+	}
+	return result
+}
+
+// mergeMapInto merges src into dst, copying anything taken from src.
+func mergeMapInto(dst, src map[string]any, strategy ArrMergeStrategy) {
+	for key, right := range src {
+		if right == nil && strategy.NilDeletes {
+			delete(dst, key)
+			continue
+		}
+		left, exists := dst[key]
+		dst[key] = mergeValue(left, exists, right, strategy)
+	}
+}
+
+// mergeValue combines an existing value with a later one. The existing value belongs to the
+// result and may be modified; the later one is copied.
+func mergeValue(left any, exists bool, right any, strategy ArrMergeStrategy) any {
+	switch r := right.(type) {
+	case map[string]any:
+		l, ok := left.(map[string]any)
+		if !ok || !exists {
+			l = make(map[string]any, len(r))
+		}
+		mergeMapInto(l, r, strategy)
+		return l
+	case []any:
+		l, ok := left.([]any)
+		if !ok || !exists {
+			return deepCopyValue(r)
+		}
+		switch strategy.Lists {
+		case ListAppend:
+			for _, item := range r {
+				l = append(l, deepCopyValue(item))
+			}
+			return l
+		case ListMergeByIndex:
+			for i, item := range r {
+				if i < len(l) {
+					l[i] = mergeValue(l[i], true, item, strategy)
+				} else {
+					l = append(l, deepCopyValue(item))
+				}
+			}
+			return l
+		}
+		return deepCopyValue(r)
+	}
+	return right
+}
+
+// deepCopyValue copies nested map[string]any and []any values. Other values are shared.
+func deepCopyValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for k, item := range v {
+			result[k] = deepCopyValue(item)
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			result[i] = deepCopyValue(item)
+		}
+		return result
+	}
+	return value
+}
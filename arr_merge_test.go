@@ -0,0 +1,127 @@
+package collections_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/qiuapeng921/collections"
+)
+
+func TestArrMergeRecursive(t *testing.T) {
+	defaults := map[string]any{
+		"name": "app",
+		"db":   map[string]any{"host": "localhost", "port": 5432, "options": map[string]any{"ssl": false}},
+	}
+	override := map[string]any{
+		"db":    map[string]any{"host": "db.internal", "options": map[string]any{"ssl": true}},
+		"debug": true,
+	}
+	merged := collections.Arr.MergeRecursive(defaults, override)
+
+	expected := map[string]any{
+		"name":  "app",
+		"debug": true,
+		"db":    map[string]any{"host": "db.internal", "port": 5432, "options": map[string]any{"ssl": true}},
+	}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Errorf("MergeRecursive failed: %v", merged)
+	}
+}
+
+func TestArrMergeRecursiveAppendsLists(t *testing.T) {
+	defaults := map[string]any{"tags": []any{"a", "b"}, "servers": []any{map[string]any{"host": "s1"}}}
+	override := map[string]any{"tags": []any{"c"}, "servers": []any{map[string]any{"weight": 5}}}
+	merged := collections.Arr.MergeRecursive(defaults, override)
+
+	if !reflect.DeepEqual(merged["tags"], []any{"a", "b", "c"}) {
+		t.Errorf("MergeRecursive list append failed: %v", merged["tags"])
+	}
+	if !reflect.DeepEqual(merged["servers"], []any{map[string]any{"host": "s1"}, map[string]any{"weight": 5}}) {
+		t.Errorf("MergeRecursive list of maps failed: %v", merged["servers"])
+	}
+}
+
+func TestArrMergeRecursiveCopies(t *testing.T) {
+	defaults := map[string]any{"db": map[string]any{"port": 5432}}
+	merged := collections.Arr.MergeRecursive(defaults, map[string]any{"name": "app"})
+
+	merged["db"].(map[string]any)["port"] = 1
+	if defaults["db"].(map[string]any)["port"] != 5432 || len(defaults) != 1 {
+		t.Error("MergeRecursive result shares maps with input")
+	}
+}
+
+func TestArrReplaceRecursive(t *testing.T) {
+	defaults := map[string]any{"db": map[string]any{"host": "localhost", "options": map[string]any{"ssl": false}}}
+	override := map[string]any{"db": map[string]any{"options": map[string]any{"ssl": true}}}
+	replaced := collections.Arr.ReplaceRecursive(defaults, override)
+
+	if collections.Arr.Get(replaced, "db.options.ssl") != true || collections.Arr.Get(replaced, "db.host") != "localhost" {
+		t.Errorf("ReplaceRecursive nested map failed: %v", replaced)
+	}
+}
+
+func TestArrReplaceRecursiveListsByIndex(t *testing.T) {
+	defaults := map[string]any{
+		"tags":    []any{"a", "b"},
+		"servers": []any{map[string]any{"host": "s1", "weight": 1}, map[string]any{"host": "s2", "weight": 1}},
+	}
+	override := map[string]any{"tags": []any{"c"}, "servers": []any{map[string]any{"weight": 5}}}
+	replaced := collections.Arr.ReplaceRecursive(defaults, override)
+
+	if !reflect.DeepEqual(replaced["tags"], []any{"c", "b"}) {
+		t.Errorf("ReplaceRecursive list by index failed: %v", replaced["tags"])
+	}
+	servers := []any{
+		map[string]any{"host": "s1", "weight": 5},
+		map[string]any{"host": "s2", "weight": 1},
+	}
+	if !reflect.DeepEqual(replaced["servers"], servers) {
+		t.Errorf("ReplaceRecursive nested list items failed: %v", replaced["servers"])
+	}
+}
+
+func TestArrMergeWithNilDeletes(t *testing.T) {
+	defaults := map[string]any{"name": "app", "db": map[string]any{"host": "localhost", "port": 5432}}
+	override := map[string]any{"name": nil, "db": map[string]any{"host": "db.internal", "port": nil}}
+	merged := collections.Arr.MergeWith(collections.ArrMergeStrategy{NilDeletes: true}, defaults, override)
+
+	if _, ok := merged["name"]; ok {
+		t.Error("Nil should delete top-level key")
+	}
+	if collections.Arr.Has(merged, "db.port") || collections.Arr.Get(merged, "db.host") != "db.internal" {
+		t.Error("Nil should delete nested key")
+	}
+	if defaults["name"] != "app" {
+		t.Error("MergeWith mutated input")
+	}
+}
+
+func TestArrMergeWithNilKept(t *testing.T) {
+	kept := collections.Arr.MergeWith(collections.ArrMergeStrategy{}, map[string]any{"name": "app"}, map[string]any{"name": nil})
+	if v, ok := kept["name"]; !ok || v != nil {
+		t.Error("Nil should be set without NilDeletes")
+	}
+}
+
+func TestArrMergeWithListReplace(t *testing.T) {
+	merged := collections.Arr.MergeWith(collections.ArrMergeStrategy{Lists: collections.ListReplace},
+		map[string]any{"tags": []any{"a", "b"}}, map[string]any{"tags": []any{"c"}})
+	if !reflect.DeepEqual(merged["tags"], []any{"c"}) {
+		t.Errorf("ListReplace failed: %v", merged["tags"])
+	}
+}
+
+func TestArrMergeWithInPlace(t *testing.T) {
+	base := map[string]any{"db": map[string]any{"host": "localhost"}}
+	layer := map[string]any{"db": map[string]any{"port": 5432}}
+
+	result := collections.Arr.MergeWith(collections.ArrMergeStrategy{InPlace: true}, base, layer)
+	if collections.Arr.Get(base, "db.port") != 5432 || collections.Arr.Get(result, "db.host") != "localhost" {
+		t.Error("InPlace merge failed")
+	}
+	layer["db"].(map[string]any)["port"] = 1
+	if collections.Arr.Get(base, "db.port") != 5432 {
+		t.Error("InPlace merge shares maps with later inputs")
+	}
+}
@@ -0,0 +1,242 @@
+package collections
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Rename renames the key at a dot-notation path to newKey within the same parent, so
+// Arr.Rename(data, "user.fname", "first_name") moves "user.fname" to "user.first_name".
+// The path may contain "*" wildcards; missing paths are skipped.
+func (ArrHelpers) Rename(data map[string]any, path, newKey string) map[string]any {
+	segments := splitPath(path)
+	if len(segments) == 0 {
+		return data
+	}
+	to := append(slices.Clone(segments[:len(segments)-1]), newKey)
+	moveMatches(data, segments, to, true)
+	return data
+}
+
+// Move moves the value at one dot-notation path to another. A "*" in from matches every
+// child, and the wildcards of to are replaced by the matched keys in order, so
+// Arr.Move(data, "items.*.qty", "items.*.stock.quantity") moves the quantity of every item.
+// Missing paths are skipped. It panics if to has more wildcards than from.
+func (ArrHelpers) Move(data map[string]any, from, to string) map[string]any {
+	moveMatches(data, splitPath(from), splitPath(to), true)
+	return data
+}
+
+// Copy copies the value at one dot-notation path to another, with the same wildcard rules
+// as Move. Maps and lists are deep-copied. It panics if to has more wildcards than from.
+func (ArrHelpers) Copy(data map[string]any, from, to string) map[string]any {
+	moveMatches(data, splitPath(from), splitPath(to), false)
+	return data
+}
+
+// Default sets the value at a dot-notation path where it is missing, like Fill, but gives
+// each match its own deep copy of the value, so "items.*.tags" can default to a list.
+func (ArrHelpers) Default(data map[string]any, path string, value any) map[string]any {
+	for _, concrete := range expandPattern(data, splitPath(path), "") {
+		segments := splitPath(concrete)
+		if _, exists := pathGet(data, segments); !exists {
+			pathSet(data, segments, deepCopyValue(value))
+		}
+	}
+	return data
+}
+
+// moveMatches copies the values matching the from segments to the to segments, removing
+// the originals if remove is set.
+func moveMatches(data map[string]any, from, to []string, remove bool) {
+	if len(from) == 0 || len(to) == 0 {
+		return
+	}
+	if countWildcards(to) > countWildcards(from) {
+		panic(fmt.Sprintf("collections: %q has more wildcards than %q", strings.Join(to, "."), strings.Join(from, ".")))
+	}
+
+	matches := matchPaths(data, from, nil)
+	values := make([]any, len(matches))
+	for i, match := range matches {
+		values[i], _ = pathGet(data, match)
+	}
+	if remove {
+		// Later matches are removed first so list indexes of earlier ones stay valid.
+		for i := len(matches) - 1; i >= 0; i-- {
+			pathForget(data, matches[i])
+		}
+	}
+	for i, match := range matches {
+		value := values[i]
+		if !remove {
+			value = deepCopyValue(value)
+		}
+		pathSet(data, fillWildcards(to, from, match), value)
+	}
+}
+
+// matchPaths expands the wildcards of a path into the concrete paths present below current.
+// Unlike expandPattern, paths with a missing segment are dropped.
+func matchPaths(current any, segments, prefix []string) [][]string {
+	if len(segments) == 0 {
+		return [][]string{prefix}
+	}
+	seg := segments[0]
+	if seg != pathWildcard {
+		next, ok := pathStep(current, seg)
+		if !ok {
+			return nil
+		}
+		return matchPaths(next, segments[1:], append(slices.Clip(prefix), seg))
+	}
+
+	keys, ok := pathKeys(current)
+	if !ok {
+		return nil
+	}
+	children, _ := pathChildren(current)
+	var result [][]string
+	for i, key := range keys {
+		result = append(result, matchPaths(children[i], segments[1:], append(slices.Clip(prefix), key))...)
+	}
+	return result
+}
+
+// fillWildcards replaces the wildcards of a target pattern with the keys that the wildcards
+// of the source pattern matched.
+func fillWildcards(target, pattern, match []string) []string {
+	var captured []string
+	for i, seg := range pattern {
+		if seg == pathWildcard {
+			captured = append(captured, match[i])
+		}
+	}
+	result := slices.Clone(target)
+	for i, seg := range result {
+		if seg == pathWildcard {
+			result[i], captured = captured[0], captured[1:]
+		}
+	}
+	return result
+}
+
+// countWildcards counts the "*" segments of a path.
+func countWildcards(segments []string) int {
+	n := 0
+	for _, seg := range segments {
+		if seg == pathWildcard {
+			n++
+		}
+	}
+	return n
+}
+
+// MigrationStep upgrades a document to Version.
+type MigrationStep struct {
+	Version     int
+	Description string
+	Up          func(data map[string]any) error
+}
+
+// MigrationReport describes the result of Migration.Apply.
+type MigrationReport struct {
+	// Data is the migrated document.
+	Data map[string]any
+	// From and To are the versions before and after the migration.
+	From int
+	To   int
+	// Applied lists the steps that ran, in order.
+	Applied []MigrationStep
+	// Changes is a JSON Patch transforming the original document into Data.
+	Changes JSONPatch
+}
+
+// Migration upgrades stored documents through versioned steps. The version of a document
+// is read from a dot-notation path, and a document without one is at version 0.
+type Migration struct {
+	versionPath string
+	steps       []MigrationStep
+}
+
+// NewMigration creates a Migration reading the document version at versionPath.
+func NewMigration(versionPath string) *Migration {
+	return &Migration{versionPath: versionPath}
+}
+
+// Step adds a step upgrading documents to version. Steps may be added in any order.
+func (m *Migration) Step(version int, description string, up func(data map[string]any) error) *Migration {
+	m.steps = append(m.steps, MigrationStep{Version: version, Description: description, Up: up})
+	return m
+}
+
+// Latest returns the highest version of the steps, or 0 if there are none.
+func (m *Migration) Latest() int {
+	latest := 0
+	for _, step := range m.steps {
+		latest = max(latest, step.Version)
+	}
+	return latest
+}
+
+// Pending returns the steps above the version of the document, in version order.
+func (m *Migration) Pending(data map[string]any) ([]MigrationStep, error) {
+	version, err := m.version(data)
+	if err != nil {
+		return nil, err
+	}
+	steps := slices.Clone(m.steps)
+	slices.SortStableFunc(steps, func(a, b MigrationStep) int {
+		return a.Version - b.Version
+	})
+	for i := 1; i < len(steps); i++ {
+		if steps[i].Version == steps[i-1].Version {
+			return nil, &InvalidArgumentException{Message: fmt.Sprintf("duplicate migration version %d", steps[i].Version)}
+		}
+	}
+	pending := steps[:0]
+	for _, step := range steps {
+		if step.Version > version {
+			pending = append(pending, step)
+		}
+	}
+	return pending, nil
+}
+
+// Apply runs the pending steps in order on a deep copy of the document, writing the version
+// of each step after it succeeds. If a step fails, the error wraps the step's error and data
+// is left unchanged.
+func (m *Migration) Apply(data map[string]any) (*MigrationReport, error) {
+	from, err := m.version(data)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := m.Pending(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := deepCopyValue(data).(map[string]any)
+	report := &MigrationReport{From: from, To: from, Applied: []MigrationStep{}}
+	for _, step := range pending {
+		if err := step.Up(result); err != nil {
+			return nil, fmt.Errorf("migration %d (%s): %w", step.Version, step.Description, err)
+		}
+		Arr.Set(result, m.versionPath, step.Version)
+		report.To = step.Version
+		report.Applied = append(report.Applied, step)
+	}
+	report.Data = result
+	report.Changes = DiffJSON(data, result)
+	return report, nil
+}
+
+// version reads the version of a document, 0 if it has none.
+func (m *Migration) version(data map[string]any) (int, error) {
+	version, err := GetAsLenient[int](data, m.versionPath)
+	if _, missing := err.(*ItemNotFoundException); missing {
+		return 0, nil
+	}
+	return version, err
+}
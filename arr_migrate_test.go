@@ -0,0 +1,174 @@
+package collections_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/qiuapeng921/collections"
+)
+
+func TestArrRename(t *testing.T) {
+	data := map[string]any{
+		"user":  map[string]any{"fname": "Ann"},
+		"items": []any{map[string]any{"qty": 1}, map[string]any{"qty": 2}, map[string]any{"sku": "x"}},
+	}
+	collections.Arr.Rename(data, "user.fname", "first_name")
+	collections.Arr.Rename(data, "items.*.qty", "quantity")
+	collections.Arr.Rename(data, "missing.key", "other")
+
+	expected := map[string]any{
+		"user":  map[string]any{"first_name": "Ann"},
+		"items": []any{map[string]any{"quantity": 1}, map[string]any{"quantity": 2}, map[string]any{"sku": "x"}},
+	}
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("Rename failed: %v", data)
+	}
+}
+
+func TestArrMove(t *testing.T) {
+	data := map[string]any{
+		"name":  "Ann",
+		"items": []any{map[string]any{"qty": 1}, map[string]any{"qty": 2}},
+	}
+	collections.Arr.Move(data, "name", "profile.name")
+	collections.Arr.Move(data, "items.*.qty", "items.*.stock.quantity")
+
+	expected := map[string]any{
+		"profile": map[string]any{"name": "Ann"},
+		"items": []any{
+			map[string]any{"stock": map[string]any{"quantity": 1}},
+			map[string]any{"stock": map[string]any{"quantity": 2}},
+		},
+	}
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("Move failed: %v", data)
+	}
+
+	list := map[string]any{"old": []any{"a", "b", "c"}}
+	collections.Arr.Move(list, "old.*", "new.*")
+	if !reflect.DeepEqual(list, map[string]any{"old": []any{}, "new": []any{"a", "b", "c"}}) {
+		t.Errorf("Move list items failed: %v", list)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Move should panic when to has more wildcards than from")
+		}
+	}()
+	collections.Arr.Move(data, "profile", "*.profile")
+}
+
+func TestArrCopy(t *testing.T) {
+	data := map[string]any{"billing": map[string]any{"city": "Oslo"}}
+	collections.Arr.Copy(data, "billing", "shipping")
+	collections.Arr.Set(data, "shipping.city", "Bergen")
+
+	if collections.Arr.Get(data, "billing.city") != "Oslo" || collections.Arr.Get(data, "shipping.city") != "Bergen" {
+		t.Errorf("Copy should deep-copy: %v", data)
+	}
+}
+
+func TestArrDefault(t *testing.T) {
+	data := map[string]any{
+		"items": []any{map[string]any{"tags": []any{"a"}}, map[string]any{}},
+	}
+	collections.Arr.Default(data, "items.*.tags", []any{})
+	collections.Arr.Default(data, "meta.version", 1)
+
+	tags := collections.Arr.Pluck(collections.Arr.Records(data["items"].([]any)), "tags")
+	if !reflect.DeepEqual(tags, []any{[]any{"a"}, []any{}}) {
+		t.Errorf("Default failed: %v", tags)
+	}
+	if collections.Arr.Get(data, "meta.version") != 1 {
+		t.Errorf("Default should create missing parents: %v", data)
+	}
+}
+
+func TestMigrationApply(t *testing.T) {
+	m := collections.NewMigration("schema").
+		Step(2, "split name", func(data map[string]any) error {
+			collections.Arr.Move(data, "name", "profile.name")
+			return nil
+		}).
+		Step(1, "rename mail", func(data map[string]any) error {
+			collections.Arr.Rename(data, "mail", "email")
+			return nil
+		})
+	if m.Latest() != 2 {
+		t.Errorf("Latest failed: %d", m.Latest())
+	}
+
+	doc := map[string]any{"name": "Ann", "mail": "ann@example.com"}
+	report, err := m.Apply(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]any{"schema": 2, "email": "ann@example.com", "profile": map[string]any{"name": "Ann"}}
+	if !reflect.DeepEqual(report.Data, expected) {
+		t.Errorf("Apply failed: %v", report.Data)
+	}
+	if report.From != 0 || report.To != 2 || len(report.Applied) != 2 || report.Applied[0].Description != "rename mail" {
+		t.Errorf("Apply report failed: %+v", report)
+	}
+	if len(report.Changes) == 0 {
+		t.Error("Apply should report the changes")
+	}
+	if _, ok := doc["schema"]; ok {
+		t.Error("Apply should not mutate the input")
+	}
+}
+
+func TestMigrationApplyUpToDate(t *testing.T) {
+	m := collections.NewMigration("schema").Step(1, "rename mail", func(data map[string]any) error {
+		collections.Arr.Rename(data, "mail", "email")
+		return nil
+	})
+	report, err := m.Apply(map[string]any{"schema": 1, "mail": "x"})
+	if err != nil || len(report.Applied) != 0 || len(report.Changes) != 0 {
+		t.Errorf("Apply on an up-to-date document should do nothing: %+v, %v", report, err)
+	}
+}
+
+func TestMigrationPending(t *testing.T) {
+	noop := func(map[string]any) error { return nil }
+	m := collections.NewMigration("schema").Step(1, "one", noop).Step(2, "two", noop)
+	pending, _ := m.Pending(map[string]any{"schema": "1"})
+	if len(pending) != 1 || pending[0].Version != 2 {
+		t.Errorf("Pending failed: %v", pending)
+	}
+}
+
+func TestMigrationStepError(t *testing.T) {
+	failure := errors.New("boom")
+	m := collections.NewMigration("schema").
+		Step(1, "move", func(data map[string]any) error {
+			collections.Arr.Move(data, "name", "profile.name")
+			return nil
+		}).
+		Step(2, "fail", func(map[string]any) error { return failure })
+	doc := map[string]any{"name": "Ann"}
+	if _, err := m.Apply(doc); !errors.Is(err, failure) {
+		t.Errorf("Apply should wrap the step error: %v", err)
+	}
+	if _, ok := doc["profile"]; ok {
+		t.Error("a failed Apply should leave the input unchanged")
+	}
+}
+
+func TestMigrationDuplicateVersion(t *testing.T) {
+	noop := func(map[string]any) error { return nil }
+	m := collections.NewMigration("schema").Step(1, "one", noop).Step(1, "again", noop)
+	var invalid *collections.InvalidArgumentException
+	if _, err := m.Apply(map[string]any{}); !errors.As(err, &invalid) {
+		t.Errorf("duplicate versions should fail: %v", err)
+	}
+}
+
+func TestMigrationInvalidVersion(t *testing.T) {
+	m := collections.NewMigration("schema").Step(1, "one", func(map[string]any) error { return nil })
+	var mismatch *collections.TypeMismatchException
+	if _, err := m.Apply(map[string]any{"schema": "v1"}); !errors.As(err, &mismatch) {
+		t.Errorf("an invalid version should fail: %v", err)
+	}
+}
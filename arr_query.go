@@ -0,0 +1,231 @@
+package collections
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Query builds a query string from a nested map, like PHP's http_build_query.
+// Nested maps are encoded as "a[b]=1" and lists of scalars as "a[]=1&a[]=2"; lists containing
+// maps or lists use indexes ("a[0][b]=1"). Keys are sorted, names and values are percent-encoded
+// per RFC 3986 (so brackets appear as %5B and %5D), booleans become 1 and 0, and nil values
+// and empty containers are omitted.
+func (ArrHelpers) Query(data map[string]any) string {
+	var parts []string
+	for _, key := range sortedKeys(data) {
+		parts = appendQuery(parts, key, data[key])
+	}
+	return strings.Join(parts, "&")
+}
+
+// ParseQuery parses a query string into a nested map, like PHP's parse_str.
+// "a[b]=1" creates nested maps, "a[]=1" appends to a list, and maps whose keys are exactly
+// 0 to n-1 become lists. A leading "?" is ignored.
+func (ArrHelpers) ParseQuery(query string) (map[string]any, error) {
+	root := make(map[string]any)
+	for _, pair := range strings.Split(strings.TrimPrefix(query, "?"), "&") {
+		if pair == "" {
+			continue
+		}
+		rawKey, rawValue, _ := strings.Cut(pair, "=")
+		key, err := url.QueryUnescape(rawKey)
+		if err != nil {
+			return nil, &InvalidArgumentException{Message: "invalid query key " + strconv.Quote(rawKey) + ": " + err.Error()}
+		}
+		value, err := url.QueryUnescape(rawValue)
+		if err != nil {
+			return nil, &InvalidArgumentException{Message: "invalid query value " + strconv.Quote(rawValue) + ": " + err.Error()}
+		}
+		insertQuery(root, querySegments(key), value)
+	}
+	return queryListsIn(root), nil
+}
+
+// FromValues converts url.Values, such as http.Request.Form, into a nested map like ParseQuery.
+// Keys are processed in sorted order and a repeated key without brackets keeps its last value.
+func (ArrHelpers) FromValues(values url.Values) map[string]any {
+	root := make(map[string]any)
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		segments := querySegments(key)
+		for _, value := range values[key] {
+			insertQuery(root, segments, value)
+		}
+	}
+	return queryListsIn(root)
+}
+
+// appendQuery appends the encoded pairs for a value under the given name.
+func appendQuery(parts []string, name string, value any) []string {
+	if value == nil {
+		return parts
+	}
+	switch v := value.(type) {
+	case map[string]any:
+		for _, key := range sortedKeys(v) {
+			parts = appendQuery(parts, name+"["+key+"]", v[key])
+		}
+		return parts
+	case []any:
+		return appendQueryList(parts, name, v)
+	case bool:
+		if v {
+			return append(parts, encodeQueryComponent(name)+"=1")
+		}
+		return append(parts, encodeQueryComponent(name)+"=0")
+	case string, []byte:
+		// Encoded as scalars below
+	default:
+		rv, ok := indirect(reflect.ValueOf(value))
+		if !ok {
+			return parts
+		}
+		switch rv.Kind() {
+		case reflect.Map:
+			for _, key := range sortedMapKeys(rv) {
+				parts = appendQuery(parts, name+"["+queryString(key.Interface())+"]", valueOf(rv.MapIndex(key)))
+			}
+			return parts
+		case reflect.Slice, reflect.Array:
+			items := make([]any, rv.Len())
+			for i := range items {
+				items[i] = valueOf(rv.Index(i))
+			}
+			return appendQueryList(parts, name, items)
+		}
+	}
+	return append(parts, encodeQueryComponent(name)+"="+encodeQueryComponent(queryString(value)))
+}
+
+// appendQueryList encodes a list, using "[]" unless an item is itself a container.
+func appendQueryList(parts []string, name string, items []any) []string {
+	indexed := false
+	for _, item := range items {
+		if _, isString := item.(string); !isString && isPathContainer(item) {
+			indexed = true
+			break
+		}
+	}
+	for i, item := range items {
+		if indexed {
+			parts = appendQuery(parts, name+"["+strconv.Itoa(i)+"]", item)
+		} else {
+			parts = appendQuery(parts, name+"[]", item)
+		}
+	}
+	return parts
+}
+
+// queryString formats a scalar for a query string.
+func queryString(value any) string {
+	if s, err := coerceString(value); err == nil {
+		return s
+	}
+	return fmt.Sprint(value)
+}
+
+// encodeQueryComponent percent-encodes everything except the RFC 3986 unreserved characters.
+func encodeQueryComponent(s string) string {
+	const hex = "0123456789ABCDEF"
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || strings.IndexByte("-._~", c) >= 0 {
+			b.WriteByte(c)
+			continue
+		}
+		b.WriteByte('%')
+		b.WriteByte(hex[c>>4])
+		b.WriteByte(hex[c&15])
+	}
+	return b.String()
+}
+
+// querySegments splits a key such as "a[b][]" into ["a", "b", ""].
+// Keys that are not well-formed are used literally.
+func querySegments(key string) []string {
+	open := strings.IndexByte(key, '[')
+	if open <= 0 {
+		return []string{key}
+	}
+	segments := []string{key[:open]}
+	rest := key[open:]
+	for rest != "" {
+		end := strings.IndexByte(rest, ']')
+		if rest[0] != '[' || end < 0 {
+			return []string{key}
+		}
+		segments = append(segments, rest[1:end])
+		rest = rest[end+1:]
+	}
+	return segments
+}
+
+// insertQuery stores a value in the nested map, treating "" segments as appends.
+func insertQuery(node map[string]any, segments []string, value string) {
+	key := segments[0]
+	if key == "" {
+		key = strconv.Itoa(nextQueryIndex(node))
+	}
+	if len(segments) == 1 {
+		node[key] = value
+		return
+	}
+	child, ok := node[key].(map[string]any)
+	if !ok {
+		child = make(map[string]any)
+		node[key] = child
+	}
+	insertQuery(child, segments[1:], value)
+}
+
+// nextQueryIndex returns one past the largest numeric key of a map.
+func nextQueryIndex(node map[string]any) int {
+	next := 0
+	for k := range node {
+		if i, ok := parseIndex(k); ok && i >= next {
+			next = i + 1
+		}
+	}
+	return next
+}
+
+// queryListsIn converts the nested values of the root map with queryLists.
+func queryListsIn(root map[string]any) map[string]any {
+	for k, v := range root {
+		root[k] = queryLists(v)
+	}
+	return root
+}
+
+// queryLists converts maps whose keys are exactly "0" to "n-1" into lists, recursively.
+func queryLists(value any) any {
+	m, ok := value.(map[string]any)
+	if !ok {
+		return value
+	}
+	isList := len(m) > 0
+	for k, v := range m {
+		m[k] = queryLists(v)
+		if i, ok := parseIndex(k); !ok || i >= len(m) || strconv.Itoa(i) != k {
+			isList = false
+		}
+	}
+	if !isList {
+		return m
+	}
+	list := make([]any, len(m))
+	for k, v := range m {
+		i, _ := parseIndex(k)
+		list[i] = v
+	}
+	return list
+}
@@ -0,0 +1,97 @@
+package collections_test
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/qiuapeng921/collections"
+)
+
+func TestArrQueryNested(t *testing.T) {
+	query := collections.Arr.Query(map[string]any{
+		"q":      "hello world & more",
+		"page":   2,
+		"active": true,
+		"skip":   nil,
+		"empty":  []any{},
+		"filter": map[string]any{
+			"tags":  []any{"go", "php"},
+			"price": map[string]any{"min": 1.5},
+		},
+		"items": []any{map[string]any{"id": 1}, map[string]any{"id": 2}},
+		"ids":   []int{3, 4},
+	})
+
+	expected := "active=1" +
+		"&filter%5Bprice%5D%5Bmin%5D=1.5" +
+		"&filter%5Btags%5D%5B%5D=go&filter%5Btags%5D%5B%5D=php" +
+		"&ids%5B%5D=3&ids%5B%5D=4" +
+		"&items%5B0%5D%5Bid%5D=1&items%5B1%5D%5Bid%5D=2" +
+		"&page=2" +
+		"&q=hello%20world%20%26%20more"
+	if query != expected {
+		t.Errorf("Query failed:\n got %s\nwant %s", query, expected)
+	}
+	if collections.Arr.Query(map[string]any{"k": "a~b-c_d.e"}) != "k=a~b-c_d.e" {
+		t.Error("Unreserved characters should not be encoded")
+	}
+}
+
+func TestArrParseQuery(t *testing.T) {
+	parsed, err := collections.Arr.ParseQuery("?q=hello+world&filter[tags][]=go&filter[tags][]=php&filter[price][min]=1.5&items[0][id]=1&items[1][id]=2&flag")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]any{
+		"q":    "hello world",
+		"flag": "",
+		"filter": map[string]any{
+			"tags":  []any{"go", "php"},
+			"price": map[string]any{"min": "1.5"},
+		},
+		"items": []any{map[string]any{"id": "1"}, map[string]any{"id": "2"}},
+	}
+	if !reflect.DeepEqual(parsed, expected) {
+		t.Errorf("ParseQuery failed: %v", parsed)
+	}
+
+	_, err = collections.Arr.ParseQuery("a=%zz")
+	var invalid *collections.InvalidArgumentException
+	if !errors.As(err, &invalid) {
+		t.Errorf("Expected InvalidArgumentException, got %v", err)
+	}
+}
+
+func TestArrQueryRoundTrip(t *testing.T) {
+	data := map[string]any{
+		"filter": map[string]any{"status": []any{"open", "closed"}, "owner": "a&b"},
+		"sort":   []any{map[string]any{"field": "created_at", "dir": "desc"}},
+	}
+	parsed, err := collections.Arr.ParseQuery(collections.Arr.Query(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(parsed, data) {
+		t.Errorf("Round trip failed: %v", parsed)
+	}
+}
+
+func TestArrFromValues(t *testing.T) {
+	values := url.Values{
+		"page":           {"1"},
+		"filter[tags][]": {"go", "php"},
+		"user[name]":     {"Jane"},
+		"plain":          {"a", "b"},
+	}
+	expected := map[string]any{
+		"page":   "1",
+		"filter": map[string]any{"tags": []any{"go", "php"}},
+		"user":   map[string]any{"name": "Jane"},
+		"plain":  "b",
+	}
+	if result := collections.Arr.FromValues(values); !reflect.DeepEqual(result, expected) {
+		t.Errorf("FromValues failed: %v", result)
+	}
+}
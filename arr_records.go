@@ -0,0 +1,229 @@
+package collections
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Records converts decoded JSON list items to rows, skipping items that are not map[string]any.
+func (ArrHelpers) Records(items []any) []map[string]any {
+	rows := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		if row, ok := item.(map[string]any); ok {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// Collect wraps rows in a Collection so the fluent API applies. Use Arr.Path and
+// Arr.PathKey to build callbacks that read a dot-notation path from each row.
+func (ArrHelpers) Collect(rows []map[string]any) *Collection[map[string]any] {
+	return New(rows)
+}
+
+// Path returns a function reading a dot-notation path from a row, for use with the
+// Collection functions: collections.Pluck(c, collections.Arr.Path("user.name")).
+// Use PathKey for GroupBy and KeyBy, since the value may be a list or map.
+func (ArrHelpers) Path(path string) func(map[string]any) any {
+	return func(row map[string]any) any {
+		return Arr.Get(row, path)
+	}
+}
+
+// PathKey returns a function reading a dot-notation path from a row formatted as a string,
+// as Arr.KeyBy does: collections.GroupBy(c, collections.Arr.PathKey("user.country")).
+func (ArrHelpers) PathKey(path string) func(map[string]any) string {
+	return func(row map[string]any) string {
+		return recordKey(row, path)
+	}
+}
+
+// Pluck returns the value at a dot-notation path from each row, nil where it is missing.
+func (ArrHelpers) Pluck(rows []map[string]any, path string) []any {
+	result := make([]any, len(rows))
+	for i, row := range rows {
+		result[i] = Arr.Get(row, path)
+	}
+	return result
+}
+
+// PluckMap returns the value at path from each row, keyed by the value at keyPath formatted
+// as a string. Later rows overwrite earlier ones with the same key.
+func (ArrHelpers) PluckMap(rows []map[string]any, path, keyPath string) map[string]any {
+	result := make(map[string]any, len(rows))
+	for _, row := range rows {
+		result[recordKey(row, keyPath)] = Arr.Get(row, path)
+	}
+	return result
+}
+
+// KeyBy keys the rows by the value at a dot-notation path formatted as a string, in the
+// order each key is first seen. Later rows overwrite earlier ones with the same key.
+func (ArrHelpers) KeyBy(rows []map[string]any, path string) *MapCollection[string, map[string]any] {
+	return KeyBy(New(rows), Arr.PathKey(path))
+}
+
+// GroupBy groups the rows by the value at a dot-notation path formatted as a string, in the
+// order each key is first seen, keeping the order of the rows within each group.
+func (ArrHelpers) GroupBy(rows []map[string]any, path string) *MapCollection[string, *Collection[map[string]any]] {
+	return GroupBy(New(rows), Arr.PathKey(path))
+}
+
+// WhereEquals returns the rows whose value at a dot-notation path equals value.
+// Numbers are compared by value, so 1 matches a decoded 1.0.
+func (ArrHelpers) WhereEquals(rows []map[string]any, path string, value any) []map[string]any {
+	result := make([]map[string]any, 0)
+	for _, row := range rows {
+		if jsonEqual(Arr.Get(row, path), value) {
+			result = append(result, row)
+		}
+	}
+	return result
+}
+
+// Sum adds up the numeric values, including numeric strings, at a dot-notation path.
+// Rows where the value is missing or not numeric are skipped.
+func (ArrHelpers) Sum(rows []map[string]any, path string) float64 {
+	total := 0.0
+	for _, row := range rows {
+		if value := Arr.Get(row, path); isNumeric(value) {
+			n, _ := coerceNumber(value)
+			total += n
+		}
+	}
+	return total
+}
+
+// SortBy returns the rows sorted by a spec such as "created_at desc, user.name".
+// Each comma-separated part is a dot-notation path optionally followed by asc or desc.
+// The sort is stable; nil sorts before booleans, numbers, strings and times. Parts with
+// an invalid direction are ignored; use SortByOrFail to reject them.
+func (ArrHelpers) SortBy(rows []map[string]any, spec string) []map[string]any {
+	keys, _ := parseSortSpec(spec)
+	return sortRecords(rows, keys)
+}
+
+// SortByOrFail is SortBy returning an InvalidArgumentException if a direction is not asc or desc.
+func (ArrHelpers) SortByOrFail(rows []map[string]any, spec string) ([]map[string]any, error) {
+	keys, err := parseSortSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	return sortRecords(rows, keys), nil
+}
+
+// sortRecords returns a stably sorted copy of the rows.
+func sortRecords(rows []map[string]any, keys []recordSortKey) []map[string]any {
+	result := slices.Clone(rows)
+	slices.SortStableFunc(result, func(a, b map[string]any) int {
+		for _, key := range keys {
+			if c := compareDynamic(Arr.Get(a, key.path), Arr.Get(b, key.path)); c != 0 {
+				if key.descending {
+					return -c
+				}
+				return c
+			}
+		}
+		return 0
+	})
+	return result
+}
+
+// recordSortKey is one part of a SortBy spec.
+type recordSortKey struct {
+	path       string
+	descending bool
+}
+
+// parseSortSpec parses "path [asc|desc], ..." into sort keys. Parts with an invalid
+// direction are skipped and reported in the error.
+func parseSortSpec(spec string) ([]recordSortKey, error) {
+	var (
+		keys []recordSortKey
+		err  error
+	)
+	for _, part := range strings.Split(spec, ",") {
+		fields := strings.Fields(part)
+		if len(fields) == 0 {
+			continue
+		}
+		key := recordSortKey{path: fields[0]}
+		if len(fields) > 2 {
+			err = &InvalidArgumentException{Message: fmt.Sprintf("invalid sort term %q", strings.TrimSpace(part))}
+			continue
+		}
+		if len(fields) == 2 {
+			switch strings.ToLower(fields[1]) {
+			case "asc":
+			case "desc":
+				key.descending = true
+			default:
+				err = &InvalidArgumentException{Message: fmt.Sprintf("invalid sort direction %q", fields[1])}
+				continue
+			}
+		}
+		keys = append(keys, key)
+	}
+	return keys, err
+}
+
+// recordKey formats the value at a path for use as a map key.
+func recordKey(row map[string]any, path string) string {
+	value := Arr.Get(row, path)
+	if value == nil {
+		return ""
+	}
+	return queryString(value)
+}
+
+// compareDynamic orders values of mixed dynamic types: nil, then booleans, numbers, strings
+// and times. Values of other types compare as equal.
+func compareDynamic(a, b any) int {
+	rankA, rankB := dynamicRank(a), dynamicRank(b)
+	if rankA != rankB {
+		return cmp.Compare(rankA, rankB)
+	}
+	switch rankA {
+	case 1:
+		return cmp.Compare(boolRank(a.(bool)), boolRank(b.(bool)))
+	case 2:
+		x, _ := coerceNumber(a)
+		y, _ := coerceNumber(b)
+		return cmp.Compare(x, y)
+	case 3:
+		return cmp.Compare(a.(string), b.(string))
+	case 4:
+		return a.(time.Time).Compare(b.(time.Time))
+	}
+	return 0
+}
+
+// dynamicRank groups values by kind for compareDynamic.
+func dynamicRank(value any) int {
+	switch value.(type) {
+	case nil:
+		return 0
+	case bool:
+		return 1
+	case string:
+		return 3
+	case time.Time:
+		return 4
+	}
+	if isNumeric(value) {
+		return 2
+	}
+	return 5
+}
+
+// boolRank orders false before true.
+func boolRank(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
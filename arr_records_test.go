@@ -0,0 +1,163 @@
+package collections_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/qiuapeng921/collections"
+)
+
+func ids(rows []map[string]any) []any {
+	return collections.Arr.Pluck(rows, "id")
+}
+
+func TestArrRecords(t *testing.T) {
+	items := []any{map[string]any{"id": 1}, "skip", map[string]any{"id": 2}}
+	if rows := collections.Arr.Records(items); len(rows) != 2 || rows[1]["id"] != 2 {
+		t.Errorf("Records failed: %v", rows)
+	}
+}
+
+func TestArrPluck(t *testing.T) {
+	rows := []map[string]any{
+		{"user": map[string]any{"name": "Ann"}},
+		{"user": map[string]any{"name": "Bob"}},
+	}
+	if names := collections.Arr.Pluck(rows, "user.name"); !reflect.DeepEqual(names, []any{"Ann", "Bob"}) {
+		t.Errorf("Pluck failed: %v", names)
+	}
+	if missing := collections.Arr.Pluck(rows, "user.email"); !reflect.DeepEqual(missing, []any{nil, nil}) {
+		t.Errorf("Pluck missing failed: %v", missing)
+	}
+}
+
+func TestArrPluckMap(t *testing.T) {
+	rows := []map[string]any{
+		{"id": 3.0, "user": map[string]any{"name": "Ann"}},
+		{"id": 1.0, "user": map[string]any{"name": "Bob"}},
+	}
+	keyed := collections.Arr.PluckMap(rows, "user.name", "id")
+	if !reflect.DeepEqual(keyed, map[string]any{"1": "Bob", "3": "Ann"}) {
+		t.Errorf("PluckMap failed: %v", keyed)
+	}
+}
+
+func TestArrKeyBy(t *testing.T) {
+	rows := []map[string]any{
+		{"status": "paid", "user": map[string]any{"name": "Cy"}},
+		{"status": "open", "user": map[string]any{"name": "Ann"}},
+	}
+	byName := collections.Arr.KeyBy(rows, "user.name")
+	if byName.Get("Ann")["status"] != "open" || !reflect.DeepEqual(byName.Keys().All(), []string{"Cy", "Ann"}) {
+		t.Errorf("KeyBy failed: %v", byName.All())
+	}
+}
+
+func TestArrGroupBy(t *testing.T) {
+	rows := []map[string]any{
+		{"id": 1, "country": "US"},
+		{"id": 2, "country": "NL"},
+		{"id": 3, "country": "US"},
+	}
+	byCountry := collections.Arr.GroupBy(rows, "country")
+	if !reflect.DeepEqual(byCountry.Keys().All(), []string{"US", "NL"}) {
+		t.Errorf("GroupBy should keep first-seen order: %v", byCountry.Keys().All())
+	}
+	if us := byCountry.Get("US"); us.Count() != 2 || us.All()[1]["id"] != 3 {
+		t.Errorf("GroupBy failed: %v", byCountry.All())
+	}
+}
+
+func TestArrWhereEquals(t *testing.T) {
+	rows := []map[string]any{{"id": 1.0, "status": "paid"}, {"id": 2.0, "status": "open"}}
+	if paid := collections.Arr.WhereEquals(rows, "status", "paid"); !reflect.DeepEqual(ids(paid), []any{1.0}) {
+		t.Errorf("WhereEquals failed: %v", ids(paid))
+	}
+	if match := collections.Arr.WhereEquals(rows, "id", 2); len(match) != 1 {
+		t.Error("WhereEquals should compare numbers by value")
+	}
+}
+
+func TestArrSum(t *testing.T) {
+	rows := []map[string]any{{"total": 20.5}, {"total": "10"}, {"total": nil}, {}}
+	if total := collections.Arr.Sum(rows, "total"); total != 30.5 {
+		t.Errorf("Sum failed: %v", total)
+	}
+}
+
+func TestArrSortBy(t *testing.T) {
+	rows := []map[string]any{
+		{"id": 3.0, "created_at": "2024-01-02"},
+		{"id": 1.0, "created_at": "2024-01-03"},
+		{"id": 2.0, "created_at": "2024-01-03"},
+	}
+	sorted := collections.Arr.SortBy(rows, "created_at desc, id")
+	if !reflect.DeepEqual(ids(sorted), []any{1.0, 2.0, 3.0}) {
+		t.Errorf("SortBy failed: %v", ids(sorted))
+	}
+	if !reflect.DeepEqual(ids(rows), []any{3.0, 1.0, 2.0}) {
+		t.Error("SortBy mutated input")
+	}
+}
+
+func TestArrSortByMixedTypes(t *testing.T) {
+	// Strings sort after numbers and nil before everything
+	rows := []map[string]any{{"id": 1, "total": "10"}, {"id": 2, "total": nil}, {"id": 3, "total": 20.5}}
+	if sorted := collections.Arr.SortBy(rows, "total DESC"); !reflect.DeepEqual(ids(sorted), []any{1, 3, 2}) {
+		t.Errorf("SortBy mixed types failed: %v", ids(sorted))
+	}
+}
+
+func TestArrSortByIgnoresInvalidTerms(t *testing.T) {
+	rows := []map[string]any{{"id": 2, "total": 5}, {"id": 1, "total": 3}}
+	if sorted := collections.Arr.SortBy(rows, "id descending, total"); !reflect.DeepEqual(ids(sorted), []any{1, 2}) {
+		t.Errorf("SortBy should ignore invalid terms: %v", ids(sorted))
+	}
+}
+
+func TestArrSortByOrFail(t *testing.T) {
+	rows := []map[string]any{{"id": 1}, {"id": 3}, {"id": 2}}
+	sorted, err := collections.Arr.SortByOrFail(rows, "id desc")
+	if err != nil || !reflect.DeepEqual(ids(sorted), []any{3, 2, 1}) {
+		t.Errorf("SortByOrFail failed: %v %v", ids(sorted), err)
+	}
+
+	var invalid *collections.InvalidArgumentException
+	if _, err := collections.Arr.SortByOrFail(rows, "id; drop"); !errors.As(err, &invalid) {
+		t.Errorf("SortByOrFail should reject invalid directions: %v", err)
+	}
+	if _, err := collections.Arr.SortByOrFail(rows, "id desc extra"); !errors.As(err, &invalid) {
+		t.Errorf("SortByOrFail should reject extra words: %v", err)
+	}
+}
+
+func TestArrCollect(t *testing.T) {
+	c := collections.Arr.Collect([]map[string]any{
+		{"status": "paid", "user": map[string]any{"name": "Ann", "country": "NL"}},
+		{"status": "open", "user": map[string]any{"name": "Bob", "country": "US"}},
+		{"status": "paid", "user": map[string]any{"name": "Cy", "country": "NL"}},
+	})
+	groups := collections.GroupBy(c, collections.Arr.PathKey("user.country"))
+	if groups.Get("NL").Count() != 2 {
+		t.Error("GroupBy over collected rows failed")
+	}
+	names := collections.Pluck(c.Filter(func(row map[string]any) bool {
+		return row["status"] == "paid"
+	}), collections.Arr.Path("user.name"))
+	if !reflect.DeepEqual(names.All(), []any{"Ann", "Cy"}) {
+		t.Errorf("Fluent pipeline failed: %v", names.All())
+	}
+}
+
+func TestArrPathKey(t *testing.T) {
+	c := collections.Arr.Collect([]map[string]any{
+		{"id": 1, "tags": []any{"a", "b"}},
+		{"id": 2, "tags": []any{"a", "b"}},
+		{"id": 3},
+	})
+	groups := collections.GroupBy(c, collections.Arr.PathKey("tags"))
+	if groups.Count() != 2 || groups.Get("").Count() != 1 {
+		t.Errorf("PathKey over list values failed: %v", groups.Keys().All())
+	}
+}
@@ -225,7 +225,7 @@ func TestArrIsList(t *testing.T) {
 }
 
 func TestArrQuery(t *testing.T) {
-	data := map[string]string{"a": "1", "b": "2"}
+	data := map[string]any{"a": "1", "b": "2"}
 	result := collections.Arr.Query(data)
 	if result == "" {
 		t.Error("Query failed")
@@ -363,3 +363,201 @@ func TestArrRandomSingleElement(t *testing.T) {
 		t.Error("Random on single should return element")
 	}
 }
+
+func TestArrGetListIndex(t *testing.T) {
+	data := map[string]any{"users": []any{map[string]any{"name": "alice"}, map[string]any{"name": "bob"}}}
+	if collections.Arr.Get(data, "users.1.name") != "bob" {
+		t.Error("Get list index failed")
+	}
+}
+
+func TestArrGetTypedSliceIndex(t *testing.T) {
+	data := map[string]any{"tags": []string{"admin", "ops"}}
+	if collections.Arr.Get(data, "tags.1") != "ops" {
+		t.Error("Get typed slice index failed")
+	}
+}
+
+func TestArrGetOutOfRangeIndex(t *testing.T) {
+	data := map[string]any{"users": []any{map[string]any{"name": "alice"}}}
+	if collections.Arr.Get(data, "users.9.name", "none") != "none" {
+		t.Error("Get out of range index failed")
+	}
+}
+
+func TestArrGetFirstLastSelectors(t *testing.T) {
+	data := map[string]any{"users": []any{"alice", "bob", "carol"}}
+	if collections.Arr.Get(data, "users.{first}") != "alice" || collections.Arr.Get(data, "users.{last}") != "carol" {
+		t.Error("Get first/last selector failed")
+	}
+}
+
+func TestArrGetWildcard(t *testing.T) {
+	data := map[string]any{"users": []any{
+		map[string]any{"email": "a@example.com"},
+		map[string]any{"email": "b@example.com"},
+		map[string]any{"name": "carol"},
+	}}
+	emails, ok := collections.Arr.Get(data, "users.*.email").([]any)
+	if !ok || len(emails) != 2 || emails[1] != "b@example.com" {
+		t.Errorf("Get wildcard failed: %v", emails)
+	}
+}
+
+func TestArrGetNestedWildcard(t *testing.T) {
+	data := map[string]any{"users": []any{
+		map[string]any{"tags": []string{"admin", "ops"}},
+		map[string]any{"tags": []string{"dev"}},
+	}}
+	tags := collections.Arr.Get(data, "users.*.tags.*").([]any)
+	if len(tags) != 3 || tags[2] != "dev" {
+		t.Errorf("Get nested wildcard failed: %v", tags)
+	}
+}
+
+func TestArrGetWildcardMissingContainer(t *testing.T) {
+	data := map[string]any{"a": 1}
+	if collections.Arr.Get(data, "missing.*.x", "default") != "default" {
+		t.Error("Get wildcard on missing container failed")
+	}
+}
+
+func TestArrSetListIndex(t *testing.T) {
+	data := map[string]any{}
+	collections.Arr.Set(data, "items.0.id", 7)
+	collections.Arr.Set(data, "items.1.id", 8)
+	items, ok := data["items"].([]any)
+	if !ok || len(items) != 2 || collections.Arr.Get(data, "items.1.id") != 8 {
+		t.Errorf("Set should create and append to lists: %v", data)
+	}
+}
+
+func TestArrSetNumericKey(t *testing.T) {
+	data := map[string]any{}
+	collections.Arr.Set(data, "codes.404", "not found")
+	if codes, ok := data["codes"].(map[string]any); !ok || codes["404"] != "not found" {
+		t.Errorf("Set should create a map for non-zero numeric segments: %v", data)
+	}
+}
+
+func TestArrSetHugeIndex(t *testing.T) {
+	data := map[string]any{"ids": []any{1}}
+	collections.Arr.Set(data, "a.99999999999999", 1)
+	collections.Arr.Set(data, "ids.2000000000", 2)
+	if collections.Arr.Get(data, "a.99999999999999") != 1 || len(data["ids"].([]any)) != 1 {
+		t.Errorf("Set with a huge index failed: %v", data)
+	}
+}
+
+func TestArrSetNilListSlot(t *testing.T) {
+	data := map[string]any{"items": []any{nil, map[string]any{"id": 7}}}
+	collections.Arr.Set(data, "items.0.id", 3)
+	if collections.Arr.Get(data, "items.0.id") != 3 {
+		t.Error("Set into nil list slot failed")
+	}
+}
+
+func TestArrSetTypedSliceElement(t *testing.T) {
+	data := map[string]any{"tags": []string{"admin", "ops"}}
+	collections.Arr.Set(data, "tags.0", "root")
+	if collections.Arr.Get(data, "tags.0") != "root" {
+		t.Error("Set typed slice element failed")
+	}
+}
+
+func TestArrSetWildcard(t *testing.T) {
+	data := map[string]any{"users": []any{map[string]any{"name": "a"}, map[string]any{"name": "b"}}}
+	collections.Arr.Set(data, "users.*.active", true)
+	if active := collections.Arr.Get(data, "users.*.active").([]any); len(active) != 2 {
+		t.Error("Set wildcard failed")
+	}
+}
+
+func TestArrFillWildcard(t *testing.T) {
+	data := map[string]any{"users": []any{map[string]any{"email": "a@example.com"}, map[string]any{"name": "b"}}}
+	collections.Arr.Fill(data, "users.*.email", "unknown@example.com")
+	if collections.Arr.Get(data, "users.0.email") != "a@example.com" || collections.Arr.Get(data, "users.1.email") != "unknown@example.com" {
+		t.Error("Fill wildcard failed")
+	}
+}
+
+func TestArrFillDoesNotOverwrite(t *testing.T) {
+	data := map[string]any{}
+	collections.Arr.Fill(data, "meta.version", 1)
+	collections.Arr.Fill(data, "meta.version", 2)
+	if collections.Arr.Get(data, "meta.version") != 1 {
+		t.Error("Fill should not overwrite")
+	}
+}
+
+func TestArrUndotLists(t *testing.T) {
+	undot := collections.Arr.Undot(map[string]any{"items.0.id": 1, "items.1.id": 2, "name": "x"})
+	items, ok := undot["items"].([]any)
+	if !ok || len(items) != 2 || collections.Arr.Get(undot, "items.1.id") != 2 {
+		t.Errorf("Undot lists failed: %v", undot)
+	}
+}
+
+func TestArrUndotMixedKeys(t *testing.T) {
+	for i := 0; i < 50; i++ {
+		undot := collections.Arr.Undot(map[string]any{"a.0": 1, "a.b": 2})
+		a, ok := undot["a"].(map[string]any)
+		if !ok || a["0"] != 1 || a["b"] != 2 {
+			t.Fatalf("Undot mixed keys failed: %v", undot)
+		}
+	}
+}
+
+func TestArrUndotDotRoundTrip(t *testing.T) {
+	data := map[string]any{"codes": map[string]any{"0": "zero", "x": "ex"}}
+	undot := collections.Arr.Undot(collections.Arr.Dot(data))
+	if codes, ok := undot["codes"].(map[string]any); !ok || codes["0"] != "zero" || codes["x"] != "ex" {
+		t.Errorf("Undot(Dot) round trip failed: %v", undot)
+	}
+}
+
+func TestArrHasWildcardWithoutMatches(t *testing.T) {
+	data := map[string]any{"users": []any{map[string]any{"name": "a"}}, "empty": []any{}}
+	if collections.Arr.Has(data, "users.*.email") || collections.Arr.Has(data, "empty.*") {
+		t.Error("Has wildcard without matches should be false")
+	}
+}
+
+func TestArrGetWildcardWithoutMatches(t *testing.T) {
+	data := map[string]any{"users": []any{map[string]any{"name": "a"}}}
+	if collections.Arr.Get(data, "users.*.email", "default") != "default" {
+		t.Error("Get wildcard without matches should return the default")
+	}
+}
+
+func TestArrForgetWildcardList(t *testing.T) {
+	data := map[string]any{"items": []any{1, 2, 3}}
+	collections.Arr.Forget(data, "items.*")
+	if items := data["items"].([]any); len(items) != 0 {
+		t.Errorf("Forget wildcard on list failed: %v", items)
+	}
+}
+
+func TestArrForgetWildcardTypedSlice(t *testing.T) {
+	data := map[string]any{"tags": []string{"a", "b"}}
+	collections.Arr.Forget(data, "tags.*")
+	if tags := data["tags"].([]string); len(tags) != 0 {
+		t.Errorf("Forget wildcard on typed slice failed: %v", tags)
+	}
+}
+
+func TestArrForgetWildcardMap(t *testing.T) {
+	data := map[string]any{"meta": map[string]any{"a": 1, "b": 2}}
+	collections.Arr.Forget(data, "meta.*")
+	if meta := data["meta"].(map[string]any); len(meta) != 0 {
+		t.Errorf("Forget wildcard on map failed: %v", meta)
+	}
+}
+
+func TestForgetPathWildcardCollection(t *testing.T) {
+	m := collections.NewMap(map[string]any{"items": collections.New([]int{1, 2, 3})})
+	collections.ForgetPath(m, "items.*")
+	if m.Get("items").(*collections.Collection[int]).Count() != 0 {
+		t.Error("Forget wildcard on Collection failed")
+	}
+}
@@ -0,0 +1,189 @@
+package collections
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// MapKeysRecursive returns a copy of the map with fn applied to every key, descending into
+// nested maps and lists. If two keys map to the same new key, the one sorting last wins.
+func (ArrHelpers) MapKeysRecursive(data map[string]any, fn func(key string) string) map[string]any {
+	return mapKeysValue(data, fn).(map[string]any)
+}
+
+// MapValuesRecursive returns a copy of the map with fn applied to every value that is not
+// a map or list. The path is the dot-notation path of the value, with list indexes ("items.0.name").
+func (ArrHelpers) MapValuesRecursive(data map[string]any, fn func(path string, value any) any) map[string]any {
+	return mapValuesValue(data, "", fn).(map[string]any)
+}
+
+// Walk calls visitor for every value in the map, parents before children, with its dot-notation
+// path and depth (0 for top-level keys). Map keys are visited in sorted order. If visitor returns
+// false for a map or list, its children are skipped.
+func (ArrHelpers) Walk(data map[string]any, visitor func(path string, value any, depth int) bool) {
+	walkChildren(data, "", 0, visitor)
+}
+
+// FilterRecursive returns a copy of the map keeping only the values for which predicate returns
+// true. Children are filtered before their parent is tested, so a predicate can drop containers
+// that became empty; lists are compacted.
+func (ArrHelpers) FilterRecursive(data map[string]any, predicate func(path string, value any) bool) map[string]any {
+	return filterValue(data, "", predicate).(map[string]any)
+}
+
+// mapKeysValue renames the keys of nested maps.
+func mapKeysValue(value any, fn func(string) string) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for _, k := range sortedKeys(v) {
+			result[fn(k)] = mapKeysValue(v[k], fn)
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			result[i] = mapKeysValue(item, fn)
+		}
+		return result
+	}
+	return value
+}
+
+// mapValuesValue transforms the leaves of nested maps and lists.
+func mapValuesValue(value any, path string, fn func(string, any) any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for k, item := range v {
+			result[k] = mapValuesValue(item, joinPath(path, k), fn)
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, item := range v {
+			result[i] = mapValuesValue(item, joinPath(path, strconv.Itoa(i)), fn)
+		}
+		return result
+	}
+	return fn(path, value)
+}
+
+// walkChildren visits the children of a map or list.
+func walkChildren(container any, path string, depth int, visitor func(string, any, int) bool) {
+	keys, children := dotChildren(container)
+	for i, key := range keys {
+		childPath := joinPath(path, key)
+		if visitor(childPath, children[i], depth) {
+			walkChildren(children[i], childPath, depth+1, visitor)
+		}
+	}
+}
+
+// filterValue filters the children of nested maps and lists.
+func filterValue(value any, path string, predicate func(string, any) bool) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for k, item := range v {
+			childPath := joinPath(path, k)
+			if filtered := filterValue(item, childPath, predicate); predicate(childPath, filtered) {
+				result[k] = filtered
+			}
+		}
+		return result
+	case []any:
+		result := make([]any, 0, len(v))
+		for i, item := range v {
+			childPath := joinPath(path, strconv.Itoa(i))
+			if filtered := filterValue(item, childPath, predicate); predicate(childPath, filtered) {
+				result = append(result, filtered)
+			}
+		}
+		return result
+	}
+	return value
+}
+
+// SnakeCase converts a key such as "userId" or "User-Name" to "user_id" or "user_name".
+func SnakeCase(s string) string {
+	return joinWords(s, "_", strings.ToLower)
+}
+
+// KebabCase converts a key such as "userId" or "user_name" to "user-id" or "user-name".
+func KebabCase(s string) string {
+	return joinWords(s, "-", strings.ToLower)
+}
+
+// PascalCase converts a key such as "user_id" or "user-name" to "UserId" or "UserName".
+func PascalCase(s string) string {
+	return joinWords(s, "", titleWord)
+}
+
+// CamelCase converts a key such as "user_id" or "UserName" to "userId" or "userName".
+func CamelCase(s string) string {
+	words := splitWords(s)
+	if len(words) == 0 {
+		return s
+	}
+	for i, word := range words {
+		if i == 0 {
+			words[i] = strings.ToLower(word)
+		} else {
+			words[i] = titleWord(word)
+		}
+	}
+	return strings.Join(words, "")
+}
+
+// joinWords splits s into words, transforms each and joins them with sep.
+func joinWords(s, sep string, transform func(string) string) string {
+	words := splitWords(s)
+	if len(words) == 0 {
+		return s
+	}
+	for i, word := range words {
+		words[i] = transform(word)
+	}
+	return strings.Join(words, sep)
+}
+
+// titleWord upper-cases the first letter of a word and lower-cases the rest.
+func titleWord(word string) string {
+	runes := []rune(strings.ToLower(word))
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
+
+// splitWords splits a key at separators and case changes, keeping acronyms together:
+// "HTTPServer_id" becomes ["HTTP", "Server", "id"].
+func splitWords(s string) []string {
+	var (
+		words   []string
+		current []rune
+	)
+	runes := []rune(s)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if len(current) > 0 {
+				words = append(words, string(current))
+				current = nil
+			}
+			continue
+		}
+		if unicode.IsUpper(r) && len(current) > 0 {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				words = append(words, string(current))
+				current = nil
+			}
+		}
+		current = append(current, r)
+	}
+	if len(current) > 0 {
+		words = append(words, string(current))
+	}
+	return words
+}
@@ -0,0 +1,150 @@
+package collections_test
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/qiuapeng921/collections"
+)
+
+func TestKeyCaseConverters(t *testing.T) {
+	cases := []struct{ in, snake, kebab, camel, pascal string }{
+		{"user_id", "user_id", "user-id", "userId", "UserId"},
+		{"userId", "user_id", "user-id", "userId", "UserId"},
+		{"UserName", "user_name", "user-name", "userName", "UserName"},
+		{"HTTPServer", "http_server", "http-server", "httpServer", "HttpServer"},
+		{"user-ID", "user_id", "user-id", "userId", "UserId"},
+		{"line_2", "line_2", "line-2", "line2", "Line2"},
+		{"__", "__", "__", "__", "__"},
+	}
+	for _, c := range cases {
+		if got := collections.SnakeCase(c.in); got != c.snake {
+			t.Errorf("SnakeCase(%q) = %q", c.in, got)
+		}
+		if got := collections.KebabCase(c.in); got != c.kebab {
+			t.Errorf("KebabCase(%q) = %q", c.in, got)
+		}
+		if got := collections.CamelCase(c.in); got != c.camel {
+			t.Errorf("CamelCase(%q) = %q", c.in, got)
+		}
+		if got := collections.PascalCase(c.in); got != c.pascal {
+			t.Errorf("PascalCase(%q) = %q", c.in, got)
+		}
+	}
+}
+
+func TestArrMapKeysRecursive(t *testing.T) {
+	data := map[string]any{
+		"home_address": map[string]any{"zip_code": "12345"},
+		"order_items":  []any{map[string]any{"unit_price": 9.5}},
+	}
+	camel := collections.Arr.MapKeysRecursive(data, collections.CamelCase)
+
+	if collections.Arr.Get(camel, "homeAddress.zipCode") != "12345" {
+		t.Error("Nested map keys not converted")
+	}
+	if collections.Arr.Get(camel, "orderItems.0.unitPrice") != 9.5 {
+		t.Error("Keys inside lists not converted")
+	}
+	if _, ok := data["home_address"]; !ok {
+		t.Error("MapKeysRecursive mutated input")
+	}
+}
+
+func TestArrMapKeysRecursiveRoundTrip(t *testing.T) {
+	data := map[string]any{"user_id": 7, "home_address": map[string]any{"zip_code": "12345"}}
+	back := collections.Arr.MapKeysRecursive(collections.Arr.MapKeysRecursive(data, collections.CamelCase), collections.SnakeCase)
+	if !reflect.DeepEqual(back, data) {
+		t.Errorf("Round trip failed: %v", back)
+	}
+}
+
+func TestArrMapValuesRecursive(t *testing.T) {
+	var paths []string
+	trimmed := collections.Arr.MapValuesRecursive(map[string]any{
+		"display_name": "  Jane  ",
+		"order_items":  []any{map[string]any{"item_id": 2, "note": " x "}},
+	}, func(path string, value any) any {
+		paths = append(paths, path)
+		if s, ok := value.(string); ok {
+			return strings.TrimSpace(s)
+		}
+		return value
+	})
+
+	if trimmed["display_name"] != "Jane" || collections.Arr.Get(trimmed, "order_items.0.note") != "x" {
+		t.Errorf("MapValuesRecursive failed: %v", trimmed)
+	}
+	if collections.Arr.Get(trimmed, "order_items.0.item_id") != 2 {
+		t.Error("MapValuesRecursive lost structure")
+	}
+	slices.Sort(paths)
+	if !reflect.DeepEqual(paths, []string{"display_name", "order_items.0.item_id", "order_items.0.note"}) {
+		t.Errorf("Unexpected leaves: %v", paths)
+	}
+}
+
+func TestArrWalk(t *testing.T) {
+	var visited []string
+	collections.Arr.Walk(map[string]any{
+		"home_address": map[string]any{"zip_code": "12345"},
+		"order_items":  []any{map[string]any{"item_id": 1}},
+		"user_id":      7,
+	}, func(path string, value any, depth int) bool {
+		visited = append(visited, strings.Repeat(">", depth)+path)
+		return path != "home_address"
+	})
+
+	expected := []string{
+		"home_address",
+		"order_items",
+		">order_items.0",
+		">>order_items.0.item_id",
+		"user_id",
+	}
+	if !reflect.DeepEqual(visited, expected) {
+		t.Errorf("Walk failed: %v", visited)
+	}
+}
+
+func TestArrFilterRecursive(t *testing.T) {
+	compact := collections.Arr.FilterRecursive(map[string]any{
+		"user_id":      7,
+		"home_address": map[string]any{"zip_code": "12345", "street_line": nil},
+		"order_items":  []any{map[string]any{"item_id": 2, "note": ""}},
+		"tags":         []any{},
+	}, func(_ string, value any) bool {
+		switch v := value.(type) {
+		case nil:
+			return false
+		case string:
+			return v != ""
+		case map[string]any:
+			return len(v) > 0
+		case []any:
+			return len(v) > 0
+		}
+		return true
+	})
+
+	expected := map[string]any{
+		"user_id":      7,
+		"home_address": map[string]any{"zip_code": "12345"},
+		"order_items":  []any{map[string]any{"item_id": 2}},
+	}
+	if !reflect.DeepEqual(compact, expected) {
+		t.Errorf("FilterRecursive failed: %v", compact)
+	}
+}
+
+func TestArrFilterRecursiveCompactsLists(t *testing.T) {
+	data := map[string]any{"items": []any{map[string]any{"id": 1}, map[string]any{"id": 2}}}
+	filtered := collections.Arr.FilterRecursive(data, func(path string, _ any) bool {
+		return path != "items.0"
+	})
+	if items := filtered["items"].([]any); len(items) != 1 || collections.Arr.Get(filtered, "items.0.id") != 2 {
+		t.Error("FilterRecursive should compact lists")
+	}
+}
@@ -0,0 +1,189 @@
+package collections
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// BiMap is a one-to-one map that keeps a forward and an inverse index in sync,
+// so both keys and values are unique and values can be looked up by key and vice versa.
+type BiMap[K comparable, V comparable] struct {
+	forward map[K]V
+	inverse map[V]K
+	keys    []K // Maintains insertion order
+}
+
+// NewBiMap creates a new empty BiMap.
+func NewBiMap[K comparable, V comparable]() *BiMap[K, V] {
+	return &BiMap[K, V]{forward: make(map[K]V), inverse: make(map[V]K), keys: make([]K, 0)}
+}
+
+// BiMapFrom creates a BiMap from a MapCollection, returning an error if two keys share a value.
+func BiMapFrom[K comparable, V comparable](m *MapCollection[K, V]) (*BiMap[K, V], error) {
+	result := NewBiMap[K, V]()
+	for _, k := range m.keys {
+		if err := result.Put(k, m.items[k]); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// Put sets a key-value pair. It returns a ConflictException if the value is already mapped
+// by a different key; use ForcePut to overwrite in that case.
+func (b *BiMap[K, V]) Put(key K, value V) error {
+	if existing, ok := b.inverse[value]; ok && existing != key {
+		return &ConflictException{Message: fmt.Sprintf("value %v is already mapped by key %v", value, existing)}
+	}
+	b.ForcePut(key, value)
+	return nil
+}
+
+// ForcePut sets a key-value pair, removing any existing entry that maps to the same value.
+func (b *BiMap[K, V]) ForcePut(key K, value V) *BiMap[K, V] {
+	if existing, ok := b.inverse[value]; ok && existing != key {
+		b.Forget(existing)
+	}
+	if old, ok := b.forward[key]; ok {
+		delete(b.inverse, old)
+	} else {
+		b.keys = append(b.keys, key)
+	}
+	b.forward[key] = value
+	b.inverse[value] = key
+	return b
+}
+
+// Lookup returns the value for a key and whether it exists.
+func (b *BiMap[K, V]) Lookup(key K) (V, bool) {
+	v, ok := b.forward[key]
+	return v, ok
+}
+
+// Get returns the value for a key.
+func (b *BiMap[K, V]) Get(key K) V {
+	return b.forward[key]
+}
+
+// LookupKey returns the key for a value and whether it exists.
+func (b *BiMap[K, V]) LookupKey(value V) (K, bool) {
+	k, ok := b.inverse[value]
+	return k, ok
+}
+
+// GetKey returns the key for a value.
+func (b *BiMap[K, V]) GetKey(value V) K {
+	return b.inverse[value]
+}
+
+// HasKey determines if a key exists.
+func (b *BiMap[K, V]) HasKey(key K) bool {
+	_, ok := b.forward[key]
+	return ok
+}
+
+// HasValue determines if a value exists.
+func (b *BiMap[K, V]) HasValue(value V) bool {
+	_, ok := b.inverse[value]
+	return ok
+}
+
+// Forget removes one or more keys and their values.
+func (b *BiMap[K, V]) Forget(keys ...K) *BiMap[K, V] {
+	for _, key := range keys {
+		value, ok := b.forward[key]
+		if !ok {
+			continue
+		}
+		delete(b.forward, key)
+		delete(b.inverse, value)
+		b.keys = slices.DeleteFunc(b.keys, func(k K) bool { return k == key })
+	}
+	return b
+}
+
+// ForgetValue removes one or more values and their keys.
+func (b *BiMap[K, V]) ForgetValue(values ...V) *BiMap[K, V] {
+	for _, value := range values {
+		if key, ok := b.inverse[value]; ok {
+			b.Forget(key)
+		}
+	}
+	return b
+}
+
+// Count returns the number of entries.
+func (b *BiMap[K, V]) Count() int {
+	return len(b.forward)
+}
+
+// IsEmpty determines if the BiMap is empty.
+func (b *BiMap[K, V]) IsEmpty() bool {
+	return len(b.forward) == 0
+}
+
+// Keys returns all keys in insertion order.
+func (b *BiMap[K, V]) Keys() *Collection[K] {
+	return New(slices.Clone(b.keys))
+}
+
+// Values returns all values in key insertion order.
+func (b *BiMap[K, V]) Values() *Collection[V] {
+	values := make([]V, len(b.keys))
+	for i, k := range b.keys {
+		values[i] = b.forward[k]
+	}
+	return New(values)
+}
+
+// Each iterates over each entry.
+func (b *BiMap[K, V]) Each(callback func(K, V)) *BiMap[K, V] {
+	for _, k := range b.keys {
+		callback(k, b.forward[k])
+	}
+	return b
+}
+
+// Inverse returns a new BiMap with keys and values swapped.
+func (b *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	result := NewBiMap[V, K]()
+	for _, k := range b.keys {
+		result.ForcePut(b.forward[k], k)
+	}
+	return result
+}
+
+// ToMap converts the BiMap to a MapCollection.
+func (b *BiMap[K, V]) ToMap() *MapCollection[K, V] {
+	result := make(map[K]V, len(b.forward))
+	for k, v := range b.forward {
+		result[k] = v
+	}
+	return NewMapOrdered(result, b.keys)
+}
+
+// FlipMapOf swaps keys and values. Unlike FlipMap it works for any comparable types and
+// returns a ConflictException listing every value that is shared by more than one key.
+func FlipMapOf[K comparable, V comparable](m *MapCollection[K, V]) (*MapCollection[V, K], error) {
+	result := make(map[V]K)
+	keys := make([]V, 0)
+	collisions := MapToMultiMap(m.ToSlice(), func(kv KeyValue[K, V], _ int) (V, K) {
+		return kv.Value, kv.Key
+	})
+
+	conflicts := make([]string, 0)
+	for _, v := range collisions.keys {
+		owners := collisions.items[v]
+		if len(owners) > 1 {
+			conflicts = append(conflicts, fmt.Sprintf("%v <- %v", v, owners))
+			continue
+		}
+		result[v] = owners[0]
+		keys = append(keys, v)
+	}
+	if len(conflicts) > 0 {
+		return nil, &ConflictException{Message: "flip collisions: " + strings.Join(conflicts, ", ")}
+	}
+	return NewMapOrdered(result, keys), nil
+}
@@ -0,0 +1,96 @@
+package collections_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/qiuapeng921/collections"
+)
+
+func TestBiMapPutAndLookup(t *testing.T) {
+	b := collections.NewBiMap[string, int]()
+	if err := b.Put("a", 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Put("b", 2); err != nil {
+		t.Fatal(err)
+	}
+	if b.Get("a") != 1 || b.GetKey(2) != "b" || !b.HasValue(1) || !b.HasKey("b") {
+		t.Error("BiMap lookup failed")
+	}
+
+	err := b.Put("c", 1)
+	var conflict *collections.ConflictException
+	if !errors.As(err, &conflict) || b.HasKey("c") {
+		t.Error("BiMap Put should reject conflicting value")
+	}
+
+	// Re-putting the same pair and changing a key's value are not conflicts
+	if b.Put("a", 1) != nil || b.Put("a", 3) != nil {
+		t.Error("BiMap Put same key failed")
+	}
+	if b.HasValue(1) || b.GetKey(3) != "a" || b.Count() != 2 {
+		t.Error("BiMap Put did not update inverse index")
+	}
+}
+
+func TestBiMapForcePut(t *testing.T) {
+	b := collections.NewBiMap[string, int]()
+	b.ForcePut("a", 1).ForcePut("b", 2).ForcePut("c", 1)
+
+	if b.HasKey("a") || b.GetKey(1) != "c" || b.Count() != 2 {
+		t.Error("BiMap ForcePut failed")
+	}
+	if keys := b.Keys().All(); len(keys) != 2 || keys[0] != "b" || keys[1] != "c" {
+		t.Errorf("BiMap ForcePut order failed: %v", keys)
+	}
+}
+
+func TestBiMapForgetAndInverse(t *testing.T) {
+	b := collections.NewBiMap[string, int]()
+	b.ForcePut("a", 1).ForcePut("b", 2).ForcePut("c", 3)
+	b.Forget("a").ForgetValue(2)
+
+	if b.Count() != 1 || b.HasValue(1) || b.HasKey("b") {
+		t.Error("BiMap Forget failed")
+	}
+	inv := b.Inverse()
+	if inv.Get(3) != "c" || inv.GetKey("c") != 3 {
+		t.Error("BiMap Inverse failed")
+	}
+	if b.ToMap().Get("c") != 3 || b.Values().First() != 3 {
+		t.Error("BiMap ToMap failed")
+	}
+}
+
+func TestBiMapFrom(t *testing.T) {
+	ok := collections.NewMapOrdered(map[string]int{"a": 1, "b": 2}, []string{"a", "b"})
+	if b, err := collections.BiMapFrom(ok); err != nil || b.GetKey(2) != "b" {
+		t.Error("BiMapFrom failed")
+	}
+	bad := collections.NewMap(map[string]int{"a": 1, "b": 1})
+	if _, err := collections.BiMapFrom(bad); err == nil {
+		t.Error("BiMapFrom should report conflicting values")
+	}
+}
+
+func TestFlipMapOf(t *testing.T) {
+	m := collections.NewMapOrdered(map[string]int{"a": 1, "b": 2}, []string{"a", "b"})
+	flipped, err := collections.FlipMapOf(m)
+	if err != nil || flipped.Get(1) != "a" || flipped.FirstKey() != 1 {
+		t.Error("FlipMapOf failed")
+	}
+
+	m.Put("c", 1)
+	if _, err := collections.FlipMapOf(m); err == nil {
+		t.Error("FlipMapOf should report collisions")
+	}
+}
+
+func TestFlipOf(t *testing.T) {
+	c := collections.New([]int{10, 20, 10})
+	flipped := collections.FlipOf(c)
+	if flipped.Get(10) != 2 || flipped.Get(20) != 1 || flipped.FirstKey() != 10 {
+		t.Error("FlipOf failed")
+	}
+}
@@ -0,0 +1,316 @@
+package collections
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"slices"
+)
+
+const wordSize = 64
+
+// MaxBitSetJSONValue is the largest value UnmarshalJSON accepts. Memory use follows the
+// largest value rather than the input size, so larger values from untrusted input are
+// rejected instead of allocating up to one bit per integer below them.
+const MaxBitSetJSONValue = 1<<26 - 1
+
+// BitSet is a set of non-negative integers backed by a dense bit array.
+// Set operations work a machine word at a time, which makes it much cheaper than
+// map-based Diff/Intersect for dense ID ranges. Negative values are ignored.
+type BitSet struct {
+	words []uint64
+}
+
+// NewBitSet creates a new BitSet containing the given values.
+func NewBitSet(values ...int) *BitSet {
+	b := &BitSet{}
+	return b.Add(values...)
+}
+
+// BitSetFromCollection creates a BitSet from a collection of integers.
+func BitSetFromCollection(c *Collection[int]) *BitSet {
+	return NewBitSet(c.items...)
+}
+
+// BitSetRange creates a BitSet containing every integer from from to to inclusive.
+func BitSetRange(from, to int) *BitSet {
+	if from > to {
+		from, to = to, from
+	}
+	from = max(from, 0)
+	b := &BitSet{}
+	if to < 0 {
+		return b
+	}
+	b.grow(to)
+	for i := from; i <= to; {
+		w, bit := i/wordSize, uint(i%wordSize)
+		if bit == 0 && i+wordSize-1 <= to {
+			b.words[w] = ^uint64(0)
+			i += wordSize
+			continue
+		}
+		b.words[w] |= 1 << bit
+		i++
+	}
+	return b
+}
+
+// grow ensures the set has room for value n.
+func (b *BitSet) grow(n int) {
+	need := n/wordSize + 1
+	if need > len(b.words) {
+		b.words = append(b.words, make([]uint64, need-len(b.words))...)
+	}
+}
+
+// trim drops trailing zero words.
+func (b *BitSet) trim() *BitSet {
+	i := len(b.words)
+	for i > 0 && b.words[i-1] == 0 {
+		i--
+	}
+	b.words = b.words[:i]
+	return b
+}
+
+// Add adds values to the set.
+func (b *BitSet) Add(values ...int) *BitSet {
+	for _, v := range values {
+		if v < 0 {
+			continue
+		}
+		b.grow(v)
+		b.words[v/wordSize] |= 1 << uint(v%wordSize)
+	}
+	return b
+}
+
+// Remove removes values from the set.
+func (b *BitSet) Remove(values ...int) *BitSet {
+	for _, v := range values {
+		if v < 0 || v/wordSize >= len(b.words) {
+			continue
+		}
+		b.words[v/wordSize] &^= 1 << uint(v%wordSize)
+	}
+	return b.trim()
+}
+
+// Contains determines if a value is in the set.
+func (b *BitSet) Contains(value int) bool {
+	if value < 0 || value/wordSize >= len(b.words) {
+		return false
+	}
+	return b.words[value/wordSize]&(1<<uint(value%wordSize)) != 0
+}
+
+// Count returns the number of values in the set.
+func (b *BitSet) Count() int {
+	count := 0
+	for _, w := range b.words {
+		count += bits.OnesCount64(w)
+	}
+	return count
+}
+
+// IsEmpty determines if the set is empty.
+func (b *BitSet) IsEmpty() bool {
+	for _, w := range b.words {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a copy of the set.
+func (b *BitSet) Clone() *BitSet {
+	return &BitSet{words: slices.Clone(b.words)}
+}
+
+// Equal determines if both sets contain the same values.
+func (b *BitSet) Equal(other *BitSet) bool {
+	return slices.Equal(b.Clone().trim().words, other.Clone().trim().words)
+}
+
+// Union returns a new set with values in either set.
+func (b *BitSet) Union(other *BitSet) *BitSet {
+	long, short := b.words, other.words
+	if len(short) > len(long) {
+		long, short = short, long
+	}
+	result := slices.Clone(long)
+	for i, w := range short {
+		result[i] |= w
+	}
+	return &BitSet{words: result}
+}
+
+// Intersection returns a new set with values in both sets.
+func (b *BitSet) Intersection(other *BitSet) *BitSet {
+	n := min(len(b.words), len(other.words))
+	result := make([]uint64, n)
+	for i := 0; i < n; i++ {
+		result[i] = b.words[i] & other.words[i]
+	}
+	return (&BitSet{words: result}).trim()
+}
+
+// Difference returns a new set with values in this set but not in the other.
+func (b *BitSet) Difference(other *BitSet) *BitSet {
+	result := slices.Clone(b.words)
+	for i := 0; i < min(len(result), len(other.words)); i++ {
+		result[i] &^= other.words[i]
+	}
+	return (&BitSet{words: result}).trim()
+}
+
+// SymmetricDifference returns a new set with values in exactly one of the sets.
+func (b *BitSet) SymmetricDifference(other *BitSet) *BitSet {
+	long, short := b.words, other.words
+	if len(short) > len(long) {
+		long, short = short, long
+	}
+	result := slices.Clone(long)
+	for i, w := range short {
+		result[i] ^= w
+	}
+	return (&BitSet{words: result}).trim()
+}
+
+// NextSet returns the smallest value in the set that is greater than or equal to from.
+func (b *BitSet) NextSet(from int) (int, bool) {
+	from = max(from, 0)
+	w := from / wordSize
+	if w >= len(b.words) {
+		return 0, false
+	}
+	word := b.words[w] >> uint(from%wordSize)
+	if word != 0 {
+		return from + bits.TrailingZeros64(word), true
+	}
+	for w++; w < len(b.words); w++ {
+		if b.words[w] != 0 {
+			return w*wordSize + bits.TrailingZeros64(b.words[w]), true
+		}
+	}
+	return 0, false
+}
+
+// Rank returns the number of values in the set that are less than or equal to value.
+func (b *BitSet) Rank(value int) int {
+	if value < 0 {
+		return 0
+	}
+	w := value / wordSize
+	if w >= len(b.words) {
+		return b.Count()
+	}
+	rank := 0
+	for i := 0; i < w; i++ {
+		rank += bits.OnesCount64(b.words[i])
+	}
+	bit := uint(value % wordSize)
+	mask := ^uint64(0)
+	if bit < wordSize-1 {
+		mask = (1 << (bit + 1)) - 1
+	}
+	return rank + bits.OnesCount64(b.words[w]&mask)
+}
+
+// Min returns the smallest value, or -1 if the set is empty.
+func (b *BitSet) Min() int {
+	if v, ok := b.NextSet(0); ok {
+		return v
+	}
+	return -1
+}
+
+// Max returns the largest value, or -1 if the set is empty.
+func (b *BitSet) Max() int {
+	for w := len(b.words) - 1; w >= 0; w-- {
+		if b.words[w] != 0 {
+			return w*wordSize + wordSize - 1 - bits.LeadingZeros64(b.words[w])
+		}
+	}
+	return -1
+}
+
+// Each iterates over values in ascending order.
+func (b *BitSet) Each(callback func(int)) *BitSet {
+	for v, ok := b.NextSet(0); ok; v, ok = b.NextSet(v + 1) {
+		callback(v)
+	}
+	return b
+}
+
+// ToSlice returns the values in ascending order.
+func (b *BitSet) ToSlice() []int {
+	result := make([]int, 0, b.Count())
+	b.Each(func(v int) {
+		result = append(result, v)
+	})
+	return result
+}
+
+// ToCollection returns the values in ascending order as a Collection.
+func (b *BitSet) ToCollection() *Collection[int] {
+	return New(b.ToSlice())
+}
+
+// MarshalBinary encodes the set as little-endian 64-bit words.
+func (b *BitSet) MarshalBinary() ([]byte, error) {
+	words := b.Clone().trim().words
+	data := make([]byte, len(words)*8)
+	for i, w := range words {
+		binary.LittleEndian.PutUint64(data[i*8:], w)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary decodes a set produced by MarshalBinary.
+func (b *BitSet) UnmarshalBinary(data []byte) error {
+	if len(data)%8 != 0 {
+		return &InvalidArgumentException{Message: "bitset data length must be a multiple of 8"}
+	}
+	words := make([]uint64, len(data)/8)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(data[i*8:])
+	}
+	b.words = words
+	b.trim()
+	return nil
+}
+
+// MarshalJSON encodes the set as a sorted JSON array of integers.
+func (b *BitSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.ToSlice())
+}
+
+// UnmarshalJSON decodes a JSON array of integers.
+// It returns an InvalidArgumentException for values above MaxBitSetJSONValue.
+func (b *BitSet) UnmarshalJSON(data []byte) error {
+	var values []int
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	for _, v := range values {
+		if v > MaxBitSetJSONValue {
+			return &InvalidArgumentException{Message: fmt.Sprintf("bitset value %d exceeds the maximum of %d", v, MaxBitSetJSONValue)}
+		}
+	}
+	b.words = nil
+	b.Add(values...)
+	return nil
+}
+
+// String returns a string representation of the set.
+func (b *BitSet) String() string {
+	data, err := b.MarshalJSON()
+	if err != nil {
+		return "[]"
+	}
+	return string(data)
+}
@@ -0,0 +1,114 @@
+package collections_test
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/qiuapeng921/collections"
+)
+
+func TestBitSetBasic(t *testing.T) {
+	b := collections.NewBitSet(1, 5, 64, 200, -3)
+	if !b.Contains(64) || b.Contains(2) || b.Contains(-3) || b.Contains(10000) {
+		t.Error("BitSet Contains failed")
+	}
+	if b.Count() != 4 {
+		t.Errorf("BitSet Count expected 4, got %d", b.Count())
+	}
+	b.Remove(200, 7)
+	if b.Contains(200) || b.Count() != 3 || b.Max() != 64 || b.Min() != 1 {
+		t.Error("BitSet Remove failed")
+	}
+	if !collections.NewBitSet().IsEmpty() || collections.NewBitSet().Max() != -1 {
+		t.Error("BitSet empty failed")
+	}
+}
+
+func TestBitSetRange(t *testing.T) {
+	b := collections.BitSetRange(3, 130)
+	if b.Count() != 128 || !b.Equal(collections.BitSetFromCollection(collections.Range(3, 130))) {
+		t.Error("BitSetRange failed")
+	}
+	if collections.BitSetRange(5, 2).Count() != 4 {
+		t.Error("BitSetRange reversed failed")
+	}
+}
+
+func TestBitSetOperations(t *testing.T) {
+	a := collections.NewBitSet(1, 2, 3, 100)
+	b := collections.NewBitSet(3, 4, 300)
+
+	if got := a.Union(b).ToSlice(); !slices.Equal(got, []int{1, 2, 3, 4, 100, 300}) {
+		t.Errorf("BitSet Union failed: %v", got)
+	}
+	if got := a.Intersection(b).ToSlice(); !slices.Equal(got, []int{3}) {
+		t.Errorf("BitSet Intersection failed: %v", got)
+	}
+	if got := a.Difference(b).ToSlice(); !slices.Equal(got, []int{1, 2, 100}) {
+		t.Errorf("BitSet Difference failed: %v", got)
+	}
+	if got := a.SymmetricDifference(b).ToSlice(); !slices.Equal(got, []int{1, 2, 4, 100, 300}) {
+		t.Errorf("BitSet SymmetricDifference failed: %v", got)
+	}
+	if a.Count() != 4 {
+		t.Error("BitSet operations mutated receiver")
+	}
+}
+
+func TestBitSetNextSetAndRank(t *testing.T) {
+	b := collections.NewBitSet(0, 63, 64, 500)
+	if v, ok := b.NextSet(1); !ok || v != 63 {
+		t.Error("BitSet NextSet failed")
+	}
+	if v, ok := b.NextSet(65); !ok || v != 500 {
+		t.Error("BitSet NextSet across words failed")
+	}
+	if _, ok := b.NextSet(501); ok {
+		t.Error("BitSet NextSet past end failed")
+	}
+	if b.Rank(0) != 1 || b.Rank(63) != 2 || b.Rank(64) != 3 || b.Rank(499) != 3 || b.Rank(10000) != 4 || b.Rank(-1) != 0 {
+		t.Error("BitSet Rank failed")
+	}
+	if c := b.ToCollection(); c.Count() != 4 || c.Last() != 500 {
+		t.Error("BitSet ToCollection failed")
+	}
+}
+
+func TestBitSetSerialization(t *testing.T) {
+	b := collections.NewBitSet(2, 70, 1000)
+
+	data, err := b.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := collections.NewBitSet()
+	if err := decoded.UnmarshalBinary(data); err != nil || !decoded.Equal(b) {
+		t.Error("BitSet binary round trip failed")
+	}
+	if err := decoded.UnmarshalBinary([]byte{1, 2, 3}); err == nil {
+		t.Error("BitSet UnmarshalBinary should reject bad length")
+	}
+
+	encoded, _ := json.Marshal(b)
+	if string(encoded) != "[2,70,1000]" {
+		t.Errorf("BitSet MarshalJSON failed: %s", encoded)
+	}
+	var fromJSON collections.BitSet
+	if err := json.Unmarshal(encoded, &fromJSON); err != nil || !fromJSON.Equal(b) {
+		t.Error("BitSet JSON round trip failed")
+	}
+}
+
+func TestBitSetUnmarshalJSONMaximum(t *testing.T) {
+	var b collections.BitSet
+	var invalid *collections.InvalidArgumentException
+	if err := json.Unmarshal([]byte("[1,9000000000000000000]"), &b); !errors.As(err, &invalid) {
+		t.Errorf("UnmarshalJSON should reject huge values: %v", err)
+	}
+	if err := json.Unmarshal([]byte("[1,"+strconv.Itoa(collections.MaxBitSetJSONValue)+"]"), &b); err != nil || !b.Contains(collections.MaxBitSetJSONValue) {
+		t.Errorf("UnmarshalJSON should accept the maximum: %v", err)
+	}
+}
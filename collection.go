@@ -699,6 +699,20 @@ func Flip(c *Collection[string]) *MapCollection[string, int] {
 	return NewMap(result)
 }
 
+// FlipOf swaps values and indices for any comparable collection.
+// Duplicate values keep the index of their last occurrence, like Flip.
+func FlipOf[T comparable](c *Collection[T]) *MapCollection[T, int] {
+	result := make(map[T]int)
+	keys := make([]T, 0)
+	for i, item := range c.items {
+		if _, exists := result[item]; !exists {
+			keys = append(keys, item)
+		}
+		result[item] = i
+	}
+	return NewMapOrdered(result, keys)
+}
+
 // Helper functions
 func abs(n int) int {
 	if n < 0 {
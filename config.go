@@ -0,0 +1,413 @@
+package collections
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Config is layered configuration built on the Arr helpers. Layers are deep-merged in the
+// order they are added, so later layers take precedence; lists are replaced, not appended.
+// Keys are dot-notation paths into the merged data. A Config is safe for concurrent use.
+type Config struct {
+	mu     sync.RWMutex
+	layers []configLayer
+	merged map[string]any
+}
+
+// configLayer is one named source of configuration.
+type configLayer struct {
+	name string
+	data map[string]any
+}
+
+// NewConfig creates an empty Config.
+func NewConfig() *Config {
+	return &Config{merged: make(map[string]any)}
+}
+
+// AddLayer adds a layer that takes precedence over the existing ones. The data is copied.
+func (c *Config) AddLayer(name string, data map[string]any) *Config {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	layer := configLayer{name: name, data: deepCopyValue(data).(map[string]any)}
+	c.layers = append(c.layers, layer)
+	c.merged = Arr.MergeWith(ArrMergeStrategy{Lists: ListReplace}, c.merged, layer.data)
+	return c
+}
+
+// LoadJSONFile adds a layer named after the file from a JSON object.
+func (c *Config) LoadJSONFile(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var data map[string]any
+	if err := json.Unmarshal(content, &data); err != nil {
+		return &InvalidArgumentException{Message: fmt.Sprintf("config file %s: %v", path, err)}
+	}
+	c.AddLayer(path, data)
+	return nil
+}
+
+// LoadEnvFile adds a layer named after the file from a .env or INI-style file.
+//
+// Lines are KEY=VALUE pairs, optionally prefixed with "export". Lines starting with # or ;
+// are comments, and [section] headers prefix the following keys with "section.". Keys are
+// lower-cased and "__" nests them, so DB__HOST=x sets "db.host". Values may be quoted with
+// double quotes (supporting \n, \t, \" and \\ escapes) or single quotes (taken literally);
+// unquoted values end at " #".
+func (c *Config) LoadEnvFile(path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	data, err := parseEnvFile(string(content))
+	if err != nil {
+		return &InvalidArgumentException{Message: fmt.Sprintf("config file %s: %v", path, err)}
+	}
+	c.AddLayer(path, data)
+	return nil
+}
+
+// LoadEnv adds a layer named "env" from the environment variables starting with prefix
+// followed by "__". The rest of the name is lower-cased and split on "__", so with the prefix
+// "APP", APP__DB__HOST sets "db.host". An empty prefix loads every variable.
+func (c *Config) LoadEnv(prefix string) *Config {
+	data := make(map[string]any)
+	for _, entry := range os.Environ() {
+		name, value, _ := strings.Cut(entry, "=")
+		if prefix != "" {
+			var ok bool
+			if name, ok = strings.CutPrefix(name, prefix+"__"); !ok {
+				continue
+			}
+		}
+		if key := envKey(name); key != "" {
+			data[key] = value
+		}
+	}
+	return c.AddLayer("env", Arr.Undot(data))
+}
+
+// Layers returns the layer names from lowest to highest precedence.
+func (c *Config) Layers() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	names := make([]string, len(c.layers))
+	for i, layer := range c.layers {
+		names[i] = layer.name
+	}
+	return names
+}
+
+// All returns a copy of the merged configuration.
+func (c *Config) All() map[string]any {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return deepCopyValue(c.merged).(map[string]any)
+}
+
+// Get retrieves a value using dot notation, or the default if it is missing.
+func (c *Config) Get(path string, defaultValue ...any) any {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return Arr.Get(c.merged, path, defaultValue...)
+}
+
+// Has determines if a path exists in any layer.
+func (c *Config) Has(path string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	_, ok := lookupAny(c.merged, path)
+	return ok
+}
+
+// String returns the value at path as a string, or the default. Values are coerced as by Arr.Lenient.
+func (c *Config) String(path string, defaultValue ...string) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return Arr.Lenient().String(c.merged, path, defaultValue...)
+}
+
+// Int returns the value at path as an int, or the default. Values are coerced as by Arr.Lenient.
+func (c *Config) Int(path string, defaultValue ...int) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return Arr.Lenient().Int(c.merged, path, defaultValue...)
+}
+
+// Float returns the value at path as a float64, or the default. Values are coerced as by Arr.Lenient.
+func (c *Config) Float(path string, defaultValue ...float64) float64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return Arr.Lenient().Float(c.merged, path, defaultValue...)
+}
+
+// Bool returns the value at path as a bool, or the default. Values are coerced as by Arr.Lenient.
+func (c *Config) Bool(path string, defaultValue ...bool) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return Arr.Lenient().Bool(c.merged, path, defaultValue...)
+}
+
+// Duration returns the value at path as a time.Duration, or the default. Values are coerced as by Arr.Lenient.
+func (c *Config) Duration(path string, defaultValue ...time.Duration) time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return Arr.Lenient().Duration(c.merged, path, defaultValue...)
+}
+
+// StringSlice returns the value at path as a []string, or the default. A comma-separated
+// string is split, so list values can come from environment variables.
+func (c *Config) StringSlice(path string, defaultValue ...[]string) []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if s, ok := Arr.Get(c.merged, path).(string); ok {
+		return splitList(s)
+	}
+	return Arr.Lenient().StringSlice(c.merged, path, defaultValue...)
+}
+
+// Source returns the name of the highest-precedence layer containing the path.
+func (c *Config) Source(path string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for i := len(c.layers) - 1; i >= 0; i-- {
+		if _, ok := lookupAny(c.layers[i].data, path); ok {
+			return c.layers[i].name, true
+		}
+	}
+	return "", false
+}
+
+// Sources returns the layer supplying each leaf of the merged configuration, keyed by dot path.
+func (c *Config) Sources() map[string]string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	result := make(map[string]string)
+	for _, layer := range c.layers {
+		Arr.Walk(layer.data, func(path string, value any, _ int) bool {
+			if _, ok := value.(map[string]any); ok {
+				// A map replacing a scalar from an earlier layer
+				delete(result, path)
+				return true
+			}
+			for key := range result {
+				// A later layer replacing a map or list owns everything below it
+				if strings.HasPrefix(key, path+".") {
+					delete(result, key)
+				}
+			}
+			result[path] = layer.name
+			return false
+		})
+	}
+	return result
+}
+
+// Bind decodes the value at path into target, which must be a non-nil pointer. Struct fields
+// match keys by json tag or name, case-insensitively and ignoring underscores, so "max_conns"
+// fills MaxConns. Scalars are coerced as by Arr.Lenient and comma-separated strings fill slices.
+// An empty path binds the whole configuration.
+func (c *Config) Bind(path string, target any) error {
+	rv := reflect.ValueOf(target)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return &InvalidArgumentException{Message: fmt.Sprintf("Bind target must be a non-nil pointer, got %T", target)}
+	}
+
+	c.mu.RLock()
+	value, ok := lookupAny(c.merged, path)
+	value = deepCopyValue(value)
+	c.mu.RUnlock()
+	if !ok {
+		return &ItemNotFoundException{Message: fmt.Sprintf("config path %q not found", path)}
+	}
+	return bindValue(rv.Elem(), value, path)
+}
+
+// bindValue decodes a configuration value into rv.
+func bindValue(rv reflect.Value, value any, path string) error {
+	if value == nil {
+		return nil
+	}
+	mismatch := &TypeMismatchException{Path: path, Expected: rv.Type().String(), Actual: fmt.Sprintf("%T", value)}
+
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		return bindValue(rv.Elem(), value, path)
+	case reflect.Interface:
+		if rv.NumMethod() == 0 {
+			rv.Set(reflect.ValueOf(value))
+			return nil
+		}
+	case reflect.Struct:
+		if rv.Type() == timeType {
+			break
+		}
+		m, ok := value.(map[string]any)
+		if !ok {
+			return mismatch
+		}
+		for _, key := range sortedKeys(m) {
+			field, ok := bindField(rv, key)
+			if !ok || !field.CanSet() {
+				continue
+			}
+			if err := bindValue(field, m[key], joinPath(path, key)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Map:
+		m, ok := value.(map[string]any)
+		if !ok || rv.Type().Key().Kind() != reflect.String {
+			return mismatch
+		}
+		result := reflect.MakeMapWithSize(rv.Type(), len(m))
+		for _, key := range sortedKeys(m) {
+			elem := reflect.New(rv.Type().Elem()).Elem()
+			if err := bindValue(elem, m[key], joinPath(path, key)); err != nil {
+				return err
+			}
+			result.SetMapIndex(reflect.ValueOf(key).Convert(rv.Type().Key()), elem)
+		}
+		rv.Set(result)
+		return nil
+	case reflect.Slice:
+		var items []any
+		switch v := value.(type) {
+		case []any:
+			items = v
+		case string:
+			for _, item := range splitList(v) {
+				items = append(items, item)
+			}
+		default:
+			return mismatch
+		}
+		result := reflect.MakeSlice(rv.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := bindValue(result.Index(i), item, joinPath(path, strconv.Itoa(i))); err != nil {
+				return err
+			}
+		}
+		rv.Set(result)
+		return nil
+	}
+
+	converted, err := coerce(value, rv.Type(), true)
+	if err != nil {
+		return mismatch
+	}
+	rv.Set(converted)
+	return nil
+}
+
+// bindField finds the struct field for a configuration key.
+func bindField(rv reflect.Value, key string) (reflect.Value, bool) {
+	if field, ok := structField(rv, key); ok {
+		return field, true
+	}
+	return structField(rv, PascalCase(key))
+}
+
+// splitList splits a comma-separated list, trimming spaces and dropping empty items.
+func splitList(s string) []string {
+	result := make([]string, 0)
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// envKey converts an environment variable name such as DB__HOST into a dot path.
+func envKey(name string) string {
+	segments := strings.Split(strings.ToLower(name), "__")
+	for _, seg := range segments {
+		if seg == "" {
+			return ""
+		}
+	}
+	return strings.Join(segments, ".")
+}
+
+// parseEnvFile parses .env and INI-style content into nested data.
+func parseEnvFile(content string) (map[string]any, error) {
+	data := make(map[string]any)
+	section := ""
+	for i, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = envKey(strings.TrimSpace(line[1 : len(line)-1]))
+			continue
+		}
+		name, raw, ok := strings.Cut(strings.TrimPrefix(line, "export "), "=")
+		key := envKey(strings.TrimSpace(name))
+		if !ok || key == "" {
+			return nil, fmt.Errorf("line %d: expected KEY=VALUE", i+1)
+		}
+		value, err := parseEnvValue(strings.TrimSpace(raw))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", i+1, err)
+		}
+		data[joinPath(section, key)] = value
+	}
+	return Arr.Undot(data), nil
+}
+
+// parseEnvValue unquotes a .env value.
+func parseEnvValue(raw string) (string, error) {
+	if raw == "" {
+		return "", nil
+	}
+	switch raw[0] {
+	case '\'':
+		end := strings.IndexByte(raw[1:], '\'')
+		if end < 0 {
+			return "", fmt.Errorf("unterminated quote")
+		}
+		return raw[1 : end+1], nil
+	case '"':
+		var b strings.Builder
+		for i := 1; i < len(raw); i++ {
+			switch raw[i] {
+			case '"':
+				return b.String(), nil
+			case '\\':
+				if i+1 == len(raw) {
+					return "", fmt.Errorf("unterminated quote")
+				}
+				i++
+				switch raw[i] {
+				case 'n':
+					b.WriteByte('\n')
+				case 't':
+					b.WriteByte('\t')
+				default:
+					b.WriteByte(raw[i])
+				}
+			default:
+				b.WriteByte(raw[i])
+			}
+		}
+		return "", fmt.Errorf("unterminated quote")
+	}
+	if comment := strings.Index(raw, " #"); comment >= 0 {
+		raw = strings.TrimSpace(raw[:comment])
+	}
+	return raw, nil
+}
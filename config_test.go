@@ -0,0 +1,209 @@
+package collections_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/qiuapeng921/collections"
+)
+
+func writeConfigFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestConfigLayers(t *testing.T) {
+	c := collections.NewConfig().
+		AddLayer("defaults", map[string]any{"db": map[string]any{"host": "default-host", "port": 5432}}).
+		AddLayer("override", map[string]any{"db": map[string]any{"host": "db.local"}})
+
+	if !reflect.DeepEqual(c.Layers(), []string{"defaults", "override"}) {
+		t.Errorf("Layers failed: %v", c.Layers())
+	}
+	if c.String("db.host") != "db.local" || c.Int("db.port") != 5432 {
+		t.Errorf("Later layers should be deep merged over earlier ones: %v", c.All())
+	}
+}
+
+func TestConfigLoadJSONFile(t *testing.T) {
+	path := writeConfigFile(t, "app.json", `{"db": {"host": "localhost", "replicas": ["r1", "r2"]}}`)
+	c := collections.NewConfig()
+	if err := c.LoadJSONFile(path); err != nil {
+		t.Fatal(err)
+	}
+	if c.String("db.host") != "localhost" || !reflect.DeepEqual(c.StringSlice("db.replicas"), []string{"r1", "r2"}) {
+		t.Errorf("LoadJSONFile failed: %v", c.All())
+	}
+	if !reflect.DeepEqual(c.Layers(), []string{path}) {
+		t.Errorf("Layer should be named after the file: %v", c.Layers())
+	}
+}
+
+func TestConfigLoadEnvFile(t *testing.T) {
+	c := collections.NewConfig()
+	err := c.LoadEnvFile(writeConfigFile(t, ".env", `
+# Local overrides
+DB__HOST=db.local
+APP__NAME="demo \"local\""
+SECRET='a#b'
+DEBUG_NOTE=on # inline comment
+
+[cache]
+ttl = 1m
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.String("db.host") != "db.local" || c.String("app.name") != `demo "local"` {
+		t.Errorf("Env file nesting or quoting failed: %v", c.All())
+	}
+	if c.String("secret") != "a#b" || c.String("debug_note") != "on" {
+		t.Errorf("Env file comments failed: %v", c.All())
+	}
+	if c.Duration("cache.ttl") != time.Minute {
+		t.Errorf("Env file section failed: %v", c.All())
+	}
+}
+
+func TestConfigLoadEnv(t *testing.T) {
+	t.Setenv("APP__DB__PORT", "6543")
+	t.Setenv("APP__FEATURES", "search, export")
+	t.Setenv("OTHER__DB__HOST", "ignored")
+
+	c := collections.NewConfig().AddLayer("defaults", map[string]any{"db": map[string]any{"port": 5432}})
+	c.LoadEnv("APP")
+	if c.Int("db.port") != 6543 {
+		t.Errorf("Environment should override earlier layers: %v", c.Get("db.port"))
+	}
+	if !reflect.DeepEqual(c.StringSlice("features"), []string{"search", "export"}) {
+		t.Errorf("StringSlice from env failed: %v", c.StringSlice("features"))
+	}
+	if c.Has("db.host") || c.Get("other") != nil {
+		t.Error("Prefix filter failed")
+	}
+}
+
+func TestConfigGetterDefaults(t *testing.T) {
+	c := collections.NewConfig().AddLayer("defaults", map[string]any{"timeout": 5, "db": map[string]any{"host": "x"}})
+	if c.Duration("timeout") != 5*time.Second {
+		t.Error("Duration from seconds failed")
+	}
+	if c.Int("missing", 9) != 9 || c.Has("missing") || !c.Has("db.host") {
+		t.Error("Defaults failed")
+	}
+}
+
+func TestConfigSources(t *testing.T) {
+	c := collections.NewConfig().
+		AddLayer("defaults", map[string]any{"db": map[string]any{"host": "localhost", "port": 5432}, "timeout": 5}).
+		AddLayer("env", map[string]any{"db": map[string]any{"port": 6543}})
+
+	if source, ok := c.Source("db.port"); !ok || source != "env" {
+		t.Errorf("Source failed: %v", source)
+	}
+	if source, _ := c.Source("db.host"); source != "defaults" {
+		t.Errorf("Source failed: %v", source)
+	}
+	if _, ok := c.Source("missing"); ok {
+		t.Error("Source of missing path should fail")
+	}
+
+	expected := map[string]string{"db.host": "defaults", "db.port": "env", "timeout": "defaults"}
+	if sources := c.Sources(); !reflect.DeepEqual(sources, expected) {
+		t.Errorf("Sources failed: %v", sources)
+	}
+}
+
+type dbConfig struct {
+	Host     string        `json:"host"`
+	Port     int           `json:"port"`
+	Replicas []string      `json:"replicas"`
+	Timeout  time.Duration `json:"timeout"`
+	MaxConns *int
+	Options  map[string]string
+}
+
+func TestConfigBind(t *testing.T) {
+	c := collections.NewConfig().AddLayer("file", map[string]any{
+		"db": map[string]any{
+			"host":      "localhost",
+			"port":      "5432",
+			"replicas":  "r1, r2",
+			"timeout":   "2s",
+			"max_conns": 10.0,
+			"options":   map[string]any{"sslmode": "disable"},
+			"unknown":   true,
+		},
+	})
+
+	var db dbConfig
+	if err := c.Bind("db", &db); err != nil {
+		t.Fatal(err)
+	}
+	if db.Host != "localhost" || db.Port != 5432 || db.Timeout != 2*time.Second {
+		t.Errorf("Bind scalars failed: %+v", db)
+	}
+	if !reflect.DeepEqual(db.Replicas, []string{"r1", "r2"}) || db.MaxConns == nil || *db.MaxConns != 10 {
+		t.Errorf("Bind slices and pointers failed: %+v", db)
+	}
+	if db.Options["sslmode"] != "disable" {
+		t.Error("Bind map failed")
+	}
+
+	var all struct{ DB dbConfig }
+	if err := c.Bind("", &all); err != nil || all.DB.Host != "localhost" {
+		t.Errorf("Bind root failed: %v", err)
+	}
+
+	var notFound *collections.ItemNotFoundException
+	if err := c.Bind("cache", &db); !errors.As(err, &notFound) {
+		t.Errorf("Expected ItemNotFoundException, got %v", err)
+	}
+	var invalid *collections.InvalidArgumentException
+	if err := c.Bind("db", db); !errors.As(err, &invalid) {
+		t.Errorf("Expected InvalidArgumentException, got %v", err)
+	}
+	var mismatch *collections.TypeMismatchException
+	var port struct{ Port bool }
+	if err := c.Bind("db", &port); !errors.As(err, &mismatch) || mismatch.Path != "db.port" {
+		t.Errorf("Expected TypeMismatchException at db.port, got %v", err)
+	}
+}
+
+func TestConfigLoadErrors(t *testing.T) {
+	c := collections.NewConfig()
+	if err := c.LoadJSONFile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("Missing file should fail")
+	}
+	var invalid *collections.InvalidArgumentException
+	if err := c.LoadJSONFile(writeConfigFile(t, "bad.json", "{")); !errors.As(err, &invalid) {
+		t.Errorf("Invalid JSON should fail, got %v", err)
+	}
+	if err := c.LoadEnvFile(writeConfigFile(t, "bad.env", "NO_EQUALS")); !errors.As(err, &invalid) {
+		t.Errorf("Invalid env line should fail, got %v", err)
+	}
+	if err := c.LoadEnvFile(writeConfigFile(t, "quote.env", `A="open`)); err == nil {
+		t.Error("Unterminated quote should fail")
+	}
+}
+
+func TestConfigEnvFileMixedKeys(t *testing.T) {
+	c := collections.NewConfig()
+	if err := c.LoadEnvFile(writeConfigFile(t, "mixed.env", "HOSTS__0=a\nHOSTS__BACKUP=b\nPORTS__0=1\nPORTS__1=2")); err != nil {
+		t.Fatal(err)
+	}
+	if c.Get("hosts.0") != "a" || c.Get("hosts.backup") != "b" {
+		t.Errorf("Mixed env keys should keep a map: %v", c.All())
+	}
+	if ports, ok := c.Get("ports").([]any); !ok || len(ports) != 2 {
+		t.Errorf("Index env keys should build a list: %v", c.Get("ports"))
+	}
+}
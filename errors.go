@@ -38,6 +38,78 @@ func (e *InvalidArgumentException) Error() string {
 	return "invalid argument"
 }
 
+// ConflictException is returned when a key or value conflicts with an existing entry.
+type ConflictException struct {
+	Message string
+}
+
+func (e *ConflictException) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	return "conflicting entry"
+}
+
+// TypeMismatchException is returned when a value cannot be converted to the requested type.
+type TypeMismatchException struct {
+	Path     string
+	Expected string
+	Actual   string
+	Message  string
+}
+
+func (e *TypeMismatchException) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	return fmt.Sprintf("path %q: expected %s, got %s", e.Path, e.Expected, e.Actual)
+}
+
+// ValidationException is returned when data fails validation.
+// Errors holds the failure messages keyed by the concrete path of each field.
+type ValidationException struct {
+	Errors  *MapCollection[string, []string]
+	Message string
+}
+
+func (e *ValidationException) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	if e.Errors != nil && e.Errors.Count() > 0 {
+		messages := e.Errors.Get(e.Errors.FirstKey())
+		if len(messages) > 0 {
+			if extra := e.Errors.Count() - 1; extra > 0 {
+				return fmt.Sprintf("%s (and %d more invalid fields)", messages[0], extra)
+			}
+			return messages[0]
+		}
+	}
+	return "the given data was invalid"
+}
+
+// PatchException is returned when an operation of a JSON Patch cannot be applied.
+// Err is the underlying error, such as an ItemNotFoundException for a missing path.
+type PatchException struct {
+	Index   int
+	Op      string
+	Path    string
+	Err     error
+	Message string
+}
+
+func (e *PatchException) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	return fmt.Sprintf("patch operation %d (%s %q): %v", e.Index, e.Op, e.Path, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *PatchException) Unwrap() error {
+	return e.Err
+}
+
 // FirstOrFail returns the first item or returns an error if empty.
 func (c *Collection[T]) FirstOrFail() (T, error) {
 	if c.IsEmpty() {
@@ -110,3 +110,53 @@ func TestLastOrFailEmpty(t *testing.T) {
 		t.Error("LastOrFail empty should return error")
 	}
 }
+
+func TestConflictExceptionError(t *testing.T) {
+	e := &collections.ConflictException{}
+	if e.Error() != "conflicting entry" {
+		t.Error("Default message failed")
+	}
+	e2 := &collections.ConflictException{Message: "custom"}
+	if e2.Error() != "custom" {
+		t.Error("Custom message failed")
+	}
+}
+
+func TestTypeMismatchExceptionError(t *testing.T) {
+	e := &collections.TypeMismatchException{Path: "a.b", Expected: "int", Actual: "string"}
+	if e.Error() != `path "a.b": expected int, got string` {
+		t.Errorf("Default message failed: %s", e.Error())
+	}
+	e2 := &collections.TypeMismatchException{Message: "custom"}
+	if e2.Error() != "custom" {
+		t.Error("Custom message failed")
+	}
+}
+
+func TestValidationExceptionError(t *testing.T) {
+	e := &collections.ValidationException{}
+	if e.Error() != "the given data was invalid" {
+		t.Error("Default message failed")
+	}
+	errs := collections.NewMap[string, []string](nil)
+	errs.Put("name", []string{"The name field is required."})
+	e2 := &collections.ValidationException{Errors: errs}
+	if e2.Error() != "The name field is required." {
+		t.Errorf("First error message failed: %s", e2.Error())
+	}
+}
+
+func TestPatchExceptionError(t *testing.T) {
+	inner := &collections.ItemNotFoundException{Message: "missing"}
+	e := &collections.PatchException{Index: 1, Op: "remove", Path: "/a", Err: inner}
+	if e.Error() != `patch operation 1 (remove "/a"): missing` {
+		t.Errorf("Default message failed: %s", e.Error())
+	}
+	if e.Unwrap() != inner {
+		t.Error("Unwrap failed")
+	}
+	e2 := &collections.PatchException{Message: "custom"}
+	if e2.Error() != "custom" {
+		t.Error("Custom message failed")
+	}
+}
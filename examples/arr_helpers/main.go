@@ -157,7 +157,7 @@ func main() {
 
 	// 16. Query - 构建查询字符串
 	fmt.Println("\n【16. Query - 构建查询字符串】")
-	params := map[string]string{"page": "1", "limit": "10", "sort": "name"}
+	params := map[string]any{"page": 1, "limit": 10, "sort": "name", "filter": map[string]any{"tags": []any{"go", "php"}}}
 	query := collections.Arr.Query(params)
 	fmt.Printf("Query: %s\n", query)
 
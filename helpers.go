@@ -1,6 +1,11 @@
 // Package collections provides helper functions for collections.
 package collections
 
+import (
+	"fmt"
+	"reflect"
+)
+
 // Value returns the value if it's not a function, or calls it if it is.
 func Value[T any](value T) T {
 	return value
@@ -18,7 +23,8 @@ func ValueFn[T any](value any) T {
 	return zero
 }
 
-// DataGet retrieves a value from nested data structures.
+// DataGet retrieves a value from nested data structures using dot notation.
+// It supports the same list indexes, wildcards and selectors as Arr.Get.
 func DataGet(target any, key string, defaultValue ...any) any {
 	if target == nil {
 		if len(defaultValue) > 0 {
@@ -36,18 +42,51 @@ func DataGet(target any, key string, defaultValue ...any) any {
 		return Arr.Get(data, key, defaultValue...)
 	}
 
+	if val, ok := pathGet(target, splitPath(key)); ok {
+		return val
+	}
 	if len(defaultValue) > 0 {
 		return defaultValue[0]
 	}
 	return nil
 }
 
-// DataSet sets a value in nested data structures.
+// DataSet sets a value in nested data structures and returns the updated target.
+// Structs are reached through pointers, so pass a pointer to modify a struct in place.
+// The result must be used when target is a list, since setting at its length appends to it.
+// Paths that cannot be set are ignored; use DataSetOrFail to get the error.
 func DataSet(target any, key string, value any) any {
-	if data, ok := target.(map[string]any); ok {
-		return Arr.Set(data, key, value)
+	result, _ := DataSetOrFail(target, key, value)
+	return result
+}
+
+// DataSetOrFail sets a value in nested data structures and returns the updated target,
+// or an error if the path cannot be set, for example through an unexported field,
+// a non-pointer struct or an incompatible type.
+func DataSetOrFail(target any, key string, value any) (any, error) {
+	if !isPathContainer(target) {
+		return target, &InvalidArgumentException{Message: fmt.Sprintf("cannot set %q on %T", key, target)}
 	}
-	return target
+	if key == "" {
+		return target, nil
+	}
+	if kind := reflect.ValueOf(target).Kind(); kind == reflect.Struct || kind == reflect.Array {
+		return target, &InvalidArgumentException{Message: fmt.Sprintf("cannot set %q on %T: pass a pointer", key, target)}
+	}
+	result, err := pathWrite(target, true, splitPath(key), value, writeSet)
+	if err != nil {
+		return result, &InvalidArgumentException{Message: fmt.Sprintf("cannot set %q: %v", key, err)}
+	}
+	return result, nil
+}
+
+// DataFill sets a value in nested data structures only where it is missing.
+func DataFill(target any, key string, value any) any {
+	if !isPathContainer(target) || key == "" {
+		return target
+	}
+	result, _ := pathWrite(target, true, splitPath(key), value, writeFill)
+	return result
 }
 
 // DataForget removes a key from nested data structures.
@@ -55,6 +94,11 @@ func DataForget(target any, keys ...string) any {
 	if data, ok := target.(map[string]any); ok {
 		return Arr.Forget(data, keys...)
 	}
+	for _, key := range keys {
+		if updated, err := pathWrite(target, true, splitPath(key), nil, writeForget); err == nil {
+			target = updated
+		}
+	}
 	return target
 }
 
@@ -0,0 +1,245 @@
+package collections
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// JSONPatchOperation is one operation of an RFC 6902 JSON Patch.
+type JSONPatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	From  string `json:"from,omitempty"`
+	Value any    `json:"value"`
+}
+
+// JSONPatch is an RFC 6902 JSON Patch document.
+type JSONPatch []JSONPatchOperation
+
+// ParseJSONPatch decodes a JSON Patch document.
+func ParseJSONPatch(data []byte) (JSONPatch, error) {
+	var patch JSONPatch
+	if err := json.Unmarshal(data, &patch); err != nil {
+		return nil, err
+	}
+	return patch, nil
+}
+
+// MarshalJSON encodes the operation, including a null value for add, replace and test.
+func (op JSONPatchOperation) MarshalJSON() ([]byte, error) {
+	fields := map[string]any{"op": op.Op, "path": op.Path}
+	switch op.Op {
+	case "add", "replace", "test":
+		fields["value"] = op.Value
+	case "move", "copy":
+		fields["from"] = op.From
+	}
+	return json.Marshal(fields)
+}
+
+// UnmarshalJSON decodes the operation, rejecting operations that lack a required member.
+func (op *JSONPatchOperation) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	var decoded struct {
+		Op    string  `json:"op"`
+		Path  *string `json:"path"`
+		From  *string `json:"from"`
+		Value any     `json:"value"`
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	_, hasValue := fields["value"]
+	switch {
+	case decoded.Path == nil:
+		return &InvalidArgumentException{Message: fmt.Sprintf("JSON patch %q operation has no path", decoded.Op)}
+	case (decoded.Op == "add" || decoded.Op == "replace" || decoded.Op == "test") && !hasValue:
+		return &InvalidArgumentException{Message: fmt.Sprintf("JSON patch %q operation has no value", decoded.Op)}
+	case (decoded.Op == "move" || decoded.Op == "copy") && decoded.From == nil:
+		return &InvalidArgumentException{Message: fmt.Sprintf("JSON patch %q operation has no from", decoded.Op)}
+	}
+	*op = JSONPatchOperation{Op: decoded.Op, Path: *decoded.Path, Value: decoded.Value}
+	if decoded.From != nil {
+		op.From = *decoded.From
+	}
+	return nil
+}
+
+// Apply applies the patch to a tree of map[string]any and []any. The patch is atomic: the
+// operations are applied to a copy, and if any fails, the error is a PatchException and doc
+// is left unchanged.
+func (p JSONPatch) Apply(doc any) (any, error) {
+	result := deepCopyValue(doc)
+	for i, op := range p {
+		var err error
+		if result, err = applyPatchOperation(result, op); err != nil {
+			return doc, &PatchException{Index: i, Op: op.Op, Path: op.Path, Err: err}
+		}
+	}
+	return result, nil
+}
+
+// ApplyJSONPatch decodes a JSON Patch document and applies it to doc.
+func ApplyJSONPatch(doc any, patch []byte) (any, error) {
+	p, err := ParseJSONPatch(patch)
+	if err != nil {
+		return doc, err
+	}
+	return p.Apply(doc)
+}
+
+// applyPatchOperation applies one operation, mutating doc.
+func applyPatchOperation(doc any, op JSONPatchOperation) (any, error) {
+	path, err := ParseJSONPointer(op.Path)
+	if err != nil {
+		return nil, err
+	}
+	switch op.Op {
+	case "add":
+		return pointerWrite(doc, path, deepCopyValue(op.Value), true)
+	case "remove":
+		updated, _, err := pointerRemove(doc, path)
+		return updated, err
+	case "replace":
+		if _, err := pointerGet(doc, path); err != nil {
+			return nil, err
+		}
+		return pointerWrite(doc, path, deepCopyValue(op.Value), false)
+	case "test":
+		current, err := pointerGet(doc, path)
+		if err != nil {
+			return nil, err
+		}
+		if !jsonEqual(current, op.Value) {
+			return nil, &ConflictException{Message: fmt.Sprintf("value at %q is %v, not %v", op.Path, current, op.Value)}
+		}
+		return doc, nil
+	case "move", "copy":
+		from, err := ParseJSONPointer(op.From)
+		if err != nil {
+			return nil, err
+		}
+		value, err := pointerGet(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		if op.Op == "copy" {
+			return pointerWrite(doc, path, deepCopyValue(value), true)
+		}
+		if len(path) > len(from) && reflect.DeepEqual(path[:len(from)], from) {
+			return nil, &InvalidArgumentException{Message: fmt.Sprintf("cannot move %q into its own child %q", op.From, op.Path)}
+		}
+		if doc, _, err = pointerRemove(doc, from); err != nil {
+			return nil, err
+		}
+		return pointerWrite(doc, path, value, true)
+	}
+	return nil, &InvalidArgumentException{Message: fmt.Sprintf("unknown JSON patch operation %q", op.Op)}
+}
+
+// ApplyMergePatch applies an RFC 7396 JSON Merge Patch and returns the result.
+// Objects in the patch are merged recursively, null members remove keys, and any other
+// patch value replaces the target. Neither input is mutated.
+func ApplyMergePatch(target, patch any) any {
+	p, ok := patch.(map[string]any)
+	if !ok {
+		return deepCopyValue(patch)
+	}
+	t, ok := deepCopyValue(target).(map[string]any)
+	if !ok {
+		t = make(map[string]any)
+	}
+	return Arr.MergeWith(ArrMergeStrategy{NilDeletes: true, InPlace: true}, t, p)
+}
+
+// DiffJSON generates a JSON Patch that transforms a into b. Numbers are compared by value,
+// so an int and a float64 holding the same number are equal.
+func DiffJSON(a, b any) JSONPatch {
+	patch := JSONPatch{}
+	diffJSON(&patch, JSONPointer{}, a, b)
+	return patch
+}
+
+// diffJSON appends the operations transforming a into b at path.
+func diffJSON(patch *JSONPatch, path JSONPointer, a, b any) {
+	if jsonEqual(a, b) {
+		return
+	}
+	switch av := a.(type) {
+	case map[string]any:
+		if bv, ok := b.(map[string]any); ok {
+			for _, k := range sortedKeys(av) {
+				if _, exists := bv[k]; !exists {
+					*patch = append(*patch, JSONPatchOperation{Op: "remove", Path: path.Append(k).String()})
+				}
+			}
+			for _, k := range sortedKeys(bv) {
+				if old, exists := av[k]; exists {
+					diffJSON(patch, path.Append(k), old, bv[k])
+				} else {
+					*patch = append(*patch, JSONPatchOperation{Op: "add", Path: path.Append(k).String(), Value: bv[k]})
+				}
+			}
+			return
+		}
+	case []any:
+		if bv, ok := b.([]any); ok {
+			common := min(len(av), len(bv))
+			for i := 0; i < common; i++ {
+				diffJSON(patch, path.Append(strconv.Itoa(i)), av[i], bv[i])
+			}
+			for i := len(av) - 1; i >= common; i-- {
+				*patch = append(*patch, JSONPatchOperation{Op: "remove", Path: path.Append(strconv.Itoa(i)).String()})
+			}
+			for i := common; i < len(bv); i++ {
+				*patch = append(*patch, JSONPatchOperation{Op: "add", Path: path.Append(strconv.Itoa(i)).String(), Value: bv[i]})
+			}
+			return
+		}
+	}
+	*patch = append(*patch, JSONPatchOperation{Op: "replace", Path: path.String(), Value: b})
+}
+
+// jsonEqual compares decoded JSON values, treating numbers of different types as equal by value.
+func jsonEqual(a, b any) bool {
+	switch av := a.(type) {
+	case map[string]any:
+		bv, ok := b.(map[string]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for k, v := range av {
+			other, exists := bv[k]
+			if !exists || !jsonEqual(v, other) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		bv, ok := b.([]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !jsonEqual(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	case string, bool, nil:
+		return a == b
+	}
+	if isNumeric(a) && isNumeric(b) {
+		if _, isString := b.(string); !isString {
+			x, _ := coerceNumber(a)
+			y, _ := coerceNumber(b)
+			return x == y
+		}
+	}
+	return reflect.DeepEqual(a, b)
+}
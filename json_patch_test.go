@@ -0,0 +1,169 @@
+package collections_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/qiuapeng921/collections"
+)
+
+func decodeJSON(t *testing.T, s string) any {
+	t.Helper()
+	var v any
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestApplyJSONPatch(t *testing.T) {
+	doc := decodeJSON(t, `{"name":"demo","tags":["a","c"],"owner":{"id":1},"old":true}`)
+	patch := `[
+		{"op":"test","path":"/name","value":"demo"},
+		{"op":"add","path":"/tags/1","value":"b"},
+		{"op":"add","path":"/tags/-","value":"d"},
+		{"op":"replace","path":"/owner/id","value":2},
+		{"op":"remove","path":"/old"},
+		{"op":"copy","from":"/owner","path":"/creator"},
+		{"op":"move","from":"/name","path":"/title"},
+		{"op":"add","path":"/nothing","value":null}
+	]`
+
+	result, err := collections.ApplyJSONPatch(doc, []byte(patch))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := decodeJSON(t, `{"title":"demo","tags":["a","b","c","d"],"owner":{"id":2},"creator":{"id":2},"nothing":null}`)
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("ApplyJSONPatch failed: %v", result)
+	}
+	if doc.(map[string]any)["name"] != "demo" {
+		t.Error("ApplyJSONPatch mutated input")
+	}
+
+	// The copy must not share the owner map
+	result.(map[string]any)["creator"].(map[string]any)["id"] = 3.0
+	if result.(map[string]any)["owner"].(map[string]any)["id"] != 2.0 {
+		t.Error("Copied value shares memory with its source")
+	}
+}
+
+func TestApplyJSONPatchAtomic(t *testing.T) {
+	doc := map[string]any{"count": 1, "items": []any{"a"}}
+	patch := collections.JSONPatch{
+		{Op: "replace", Path: "/count", Value: 2},
+		{Op: "add", Path: "/items/-", Value: "b"},
+		{Op: "test", Path: "/count", Value: 3},
+	}
+
+	result, err := patch.Apply(doc)
+	var patchErr *collections.PatchException
+	if !errors.As(err, &patchErr) || patchErr.Index != 2 || patchErr.Op != "test" {
+		t.Fatalf("Expected PatchException for operation 2, got %v", err)
+	}
+	var conflict *collections.ConflictException
+	if !errors.As(err, &conflict) {
+		t.Error("PatchException should wrap a ConflictException for a failed test")
+	}
+	if !reflect.DeepEqual(result, doc) || doc["count"] != 1 || len(doc["items"].([]any)) != 1 {
+		t.Errorf("Failed patch should leave the document unchanged: %v", doc)
+	}
+
+	_, err = collections.JSONPatch{{Op: "replace", Path: "/missing", Value: 1}}.Apply(doc)
+	var notFound *collections.ItemNotFoundException
+	if !errors.As(err, &notFound) {
+		t.Errorf("Replace of missing path should wrap ItemNotFoundException, got %v", err)
+	}
+	if _, err := (collections.JSONPatch{{Op: "move", From: "/items", Path: "/items/0"}}).Apply(doc); err == nil {
+		t.Error("Move into own child should fail")
+	}
+	if _, err := (collections.JSONPatch{{Op: "bogus", Path: "/"}}).Apply(doc); err == nil {
+		t.Error("Unknown operation should fail")
+	}
+}
+
+func TestParseJSONPatchValidation(t *testing.T) {
+	for _, invalid := range []string{
+		`[{"op":"add","path":"/a"}]`,
+		`[{"op":"remove"}]`,
+		`[{"op":"move","path":"/a"}]`,
+	} {
+		if _, err := collections.ParseJSONPatch([]byte(invalid)); err == nil {
+			t.Errorf("Expected error for %s", invalid)
+		}
+	}
+
+	patch := collections.JSONPatch{
+		{Op: "add", Path: "/a", Value: nil},
+		{Op: "remove", Path: "/b"},
+		{Op: "move", From: "/c", Path: "/d"},
+	}
+	data, err := json.Marshal(patch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `[{"op":"add","path":"/a","value":null},{"op":"remove","path":"/b"},{"from":"/c","op":"move","path":"/d"}]`
+	if string(data) != expected {
+		t.Errorf("Marshal failed: %s", data)
+	}
+	parsed, err := collections.ParseJSONPatch(data)
+	if err != nil || !reflect.DeepEqual(parsed, patch) {
+		t.Errorf("Round trip failed: %v %v", parsed, err)
+	}
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	target := decodeJSON(t, `{"a":"b","c":{"d":"e","f":"g"},"list":[1,2]}`)
+	patch := decodeJSON(t, `{"a":"z","c":{"f":null},"list":[3],"new":{"x":null,"y":1}}`)
+
+	result := collections.ApplyMergePatch(target, patch)
+	expected := decodeJSON(t, `{"a":"z","c":{"d":"e"},"list":[3],"new":{"y":1}}`)
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("ApplyMergePatch failed: %v", result)
+	}
+	if target.(map[string]any)["a"] != "b" {
+		t.Error("ApplyMergePatch mutated input")
+	}
+	if collections.ApplyMergePatch(target, "scalar") != "scalar" {
+		t.Error("Non-object patch should replace the target")
+	}
+	if !reflect.DeepEqual(collections.ApplyMergePatch("scalar", map[string]any{"a": 1}), map[string]any{"a": 1}) {
+		t.Error("Object patch on a non-object target should start from an empty object")
+	}
+}
+
+func TestDiffJSON(t *testing.T) {
+	a := decodeJSON(t, `{"name":"demo","tags":["a","b","c"],"owner":{"id":1,"role":"admin"},"n":1}`)
+	b := map[string]any{
+		"name":  "demo",
+		"tags":  []any{"a", "x"},
+		"owner": map[string]any{"id": 2},
+		"n":     1,
+		"new":   true,
+	}
+
+	patch := collections.DiffJSON(a, b)
+	expected := collections.JSONPatch{
+		{Op: "add", Path: "/new", Value: true},
+		{Op: "remove", Path: "/owner/role"},
+		{Op: "replace", Path: "/owner/id", Value: 2},
+		{Op: "replace", Path: "/tags/1", Value: "x"},
+		{Op: "remove", Path: "/tags/2"},
+	}
+	if !reflect.DeepEqual(patch, expected) {
+		t.Errorf("DiffJSON failed: %v", patch)
+	}
+
+	result, err := patch.Apply(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(collections.DiffJSON(result, b)) != 0 {
+		t.Errorf("Applying the diff should produce b: %v", result)
+	}
+	if len(collections.DiffJSON(a, a)) != 0 {
+		t.Error("Diff of equal documents should be empty")
+	}
+}
@@ -0,0 +1,225 @@
+package collections
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// JSONPointer is a parsed RFC 6901 JSON Pointer: the unescaped reference tokens.
+// The empty pointer refers to the whole document.
+type JSONPointer []string
+
+// ParseJSONPointer parses a JSON Pointer such as "/users/0/name".
+func ParseJSONPointer(pointer string) (JSONPointer, error) {
+	if pointer == "" {
+		return JSONPointer{}, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, &InvalidArgumentException{Message: fmt.Sprintf("JSON pointer %q must start with /", pointer)}
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		for j := 0; j < len(token); j++ {
+			if token[j] == '~' && (j+1 == len(token) || (token[j+1] != '0' && token[j+1] != '1')) {
+				return nil, &InvalidArgumentException{Message: fmt.Sprintf("JSON pointer %q has an invalid escape", pointer)}
+			}
+		}
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// String returns the escaped form of the pointer.
+func (p JSONPointer) String() string {
+	var b strings.Builder
+	for _, token := range p {
+		b.WriteByte('/')
+		b.WriteString(strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1"))
+	}
+	return b.String()
+}
+
+// Append returns a new pointer with the tokens added.
+func (p JSONPointer) Append(tokens ...string) JSONPointer {
+	return append(slices.Clip(p), tokens...)
+}
+
+// JSONPointerGet returns the value a JSON Pointer refers to in a tree of map[string]any and []any.
+func JSONPointerGet(doc any, pointer string) (any, error) {
+	tokens, err := ParseJSONPointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+	return pointerGet(doc, tokens)
+}
+
+// JSONPointerSet sets the value a JSON Pointer refers to, creating an object member or
+// replacing a list element. The index "-" or the list length appends. Maps and lists are
+// modified in place; the returned document differs from doc only when the pointer is empty
+// or a list at the top level grows.
+func JSONPointerSet(doc any, pointer string, value any) (any, error) {
+	tokens, err := ParseJSONPointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+	return pointerWrite(doc, tokens, value, false)
+}
+
+// JSONPointerRemove removes the value a JSON Pointer refers to and returns the updated document.
+func JSONPointerRemove(doc any, pointer string) (any, error) {
+	tokens, err := ParseJSONPointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+	updated, _, err := pointerRemove(doc, tokens)
+	return updated, err
+}
+
+// pointerGet walks the tokens from node.
+func pointerGet(node any, tokens JSONPointer) (any, error) {
+	for i, token := range tokens {
+		switch n := node.(type) {
+		case map[string]any:
+			child, ok := n[token]
+			if !ok {
+				return nil, pointerNotFound(tokens[:i+1])
+			}
+			node = child
+		case []any:
+			index, err := pointerIndex(tokens[:i+1], len(n), false)
+			if err != nil {
+				return nil, err
+			}
+			node = n[index]
+		default:
+			return nil, pointerNotFound(tokens[:i+1])
+		}
+	}
+	return node, nil
+}
+
+// pointerWrite stores value at the tokens below node and returns the updated node.
+// With insert, list elements are inserted as in a JSON Patch "add"; otherwise they are replaced.
+func pointerWrite(node any, tokens JSONPointer, value any, insert bool) (any, error) {
+	return pointerWriteAt(node, tokens, 0, value, insert)
+}
+
+func pointerWriteAt(node any, tokens JSONPointer, depth int, value any, insert bool) (any, error) {
+	if depth == len(tokens) {
+		return value, nil
+	}
+	token, last := tokens[depth], depth == len(tokens)-1
+	switch n := node.(type) {
+	case map[string]any:
+		if last {
+			n[token] = value
+			return n, nil
+		}
+		child, ok := n[token]
+		if !ok {
+			return nil, pointerNotFound(tokens[:depth+1])
+		}
+		updated, err := pointerWriteAt(child, tokens, depth+1, value, insert)
+		if err != nil {
+			return nil, err
+		}
+		n[token] = updated
+		return n, nil
+	case []any:
+		if !last {
+			index, err := pointerIndex(tokens[:depth+1], len(n), false)
+			if err != nil {
+				return nil, err
+			}
+			updated, err := pointerWriteAt(n[index], tokens, depth+1, value, insert)
+			if err != nil {
+				return nil, err
+			}
+			n[index] = updated
+			return n, nil
+		}
+		index, err := pointerIndex(tokens[:depth+1], len(n), true)
+		if err != nil {
+			return nil, err
+		}
+		if index == len(n) {
+			return append(n, value), nil
+		}
+		if insert {
+			return slices.Insert(n, index, value), nil
+		}
+		n[index] = value
+		return n, nil
+	}
+	return nil, pointerNotFound(tokens[:depth+1])
+}
+
+// pointerRemove removes the value at the tokens below node, returning the updated node and
+// the removed value.
+func pointerRemove(node any, tokens JSONPointer) (any, any, error) {
+	return pointerRemoveAt(node, tokens, 0)
+}
+
+func pointerRemoveAt(node any, tokens JSONPointer, depth int) (any, any, error) {
+	if depth == len(tokens) {
+		return nil, node, nil
+	}
+	token, last := tokens[depth], depth == len(tokens)-1
+	switch n := node.(type) {
+	case map[string]any:
+		child, ok := n[token]
+		if !ok {
+			return nil, nil, pointerNotFound(tokens[:depth+1])
+		}
+		if last {
+			delete(n, token)
+			return n, child, nil
+		}
+		updated, removed, err := pointerRemoveAt(child, tokens, depth+1)
+		if err != nil {
+			return nil, nil, err
+		}
+		n[token] = updated
+		return n, removed, nil
+	case []any:
+		index, err := pointerIndex(tokens[:depth+1], len(n), false)
+		if err != nil {
+			return nil, nil, err
+		}
+		removed := n[index]
+		if last {
+			return slices.Delete(n, index, index+1), removed, nil
+		}
+		updated, removed, err := pointerRemoveAt(removed, tokens, depth+1)
+		if err != nil {
+			return nil, nil, err
+		}
+		n[index] = updated
+		return n, removed, nil
+	}
+	return nil, nil, pointerNotFound(tokens[:depth+1])
+}
+
+// pointerIndex parses the last token of pointer as an index into a list of length n.
+// With allowEnd, "-" and n itself refer to the position after the last element.
+func pointerIndex(pointer JSONPointer, n int, allowEnd bool) (int, error) {
+	token := pointer[len(pointer)-1]
+	if token == "-" && allowEnd {
+		return n, nil
+	}
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || (len(token) > 1 && token[0] == '0') {
+		return 0, &InvalidArgumentException{Message: fmt.Sprintf("JSON pointer %q has an invalid list index", pointer.String())}
+	}
+	if i > n || (i == n && !allowEnd) {
+		return 0, pointerNotFound(pointer)
+	}
+	return i, nil
+}
+
+// pointerNotFound reports a pointer that does not refer to a value.
+func pointerNotFound(pointer JSONPointer) error {
+	return &ItemNotFoundException{Message: fmt.Sprintf("JSON pointer %q not found", pointer.String())}
+}
@@ -0,0 +1,122 @@
+package collections_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/qiuapeng921/collections"
+)
+
+func TestParseJSONPointer(t *testing.T) {
+	p, err := collections.ParseJSONPointer("/a~1b/m~0n/0")
+	if err != nil || !reflect.DeepEqual(p, collections.JSONPointer{"a/b", "m~n", "0"}) {
+		t.Errorf("ParseJSONPointer failed: %v %v", p, err)
+	}
+	if p.String() != "/a~1b/m~0n/0" {
+		t.Errorf("String failed: %s", p.String())
+	}
+	for _, invalid := range []string{"a", "/bad~2", "/trailing~"} {
+		if _, err := collections.ParseJSONPointer(invalid); err == nil {
+			t.Errorf("Expected error for %q", invalid)
+		}
+	}
+}
+
+func TestJSONPointerGet(t *testing.T) {
+	doc := map[string]any{
+		"foo": []any{"bar", "baz"},
+		"a/b": 1,
+		"m~n": 8,
+		"":    0,
+		"obj": map[string]any{"x": map[string]any{"y": true}},
+	}
+	cases := map[string]any{
+		"/foo/0":   "bar",
+		"/foo/1":   "baz",
+		"/a~1b":    1,
+		"/m~0n":    8,
+		"/":        0,
+		"/obj/x/y": true,
+		"/obj/x":   map[string]any{"y": true},
+	}
+	for pointer, expected := range cases {
+		value, err := collections.JSONPointerGet(doc, pointer)
+		if err != nil || !reflect.DeepEqual(value, expected) {
+			t.Errorf("JSONPointerGet(%q) = %v, %v", pointer, value, err)
+		}
+	}
+}
+
+func TestJSONPointerGetWholeDocument(t *testing.T) {
+	doc := map[string]any{"a": 1}
+	if whole, _ := collections.JSONPointerGet(doc, ""); !reflect.DeepEqual(whole, doc) {
+		t.Error("Empty pointer should return the document")
+	}
+}
+
+func TestJSONPointerGetMissing(t *testing.T) {
+	doc := map[string]any{"foo": []any{"bar"}, "obj": map[string]any{}}
+	var notFound *collections.ItemNotFoundException
+	if _, err := collections.JSONPointerGet(doc, "/foo/1"); !errors.As(err, &notFound) {
+		t.Errorf("Expected ItemNotFoundException, got %v", err)
+	}
+	if _, err := collections.JSONPointerGet(doc, "/obj/missing/y"); err == nil || err.Error() != `JSON pointer "/obj/missing" not found` {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestJSONPointerGetLeadingZero(t *testing.T) {
+	doc := map[string]any{"foo": []any{"bar", "baz"}}
+	var invalid *collections.InvalidArgumentException
+	if _, err := collections.JSONPointerGet(doc, "/foo/01"); !errors.As(err, &invalid) {
+		t.Errorf("Expected InvalidArgumentException for leading zero, got %v", err)
+	}
+}
+
+func TestJSONPointerSetList(t *testing.T) {
+	var doc any = map[string]any{"foo": []any{"bar", "baz"}}
+	doc, err := collections.JSONPointerSet(doc, "/foo/-", "qux")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc, err = collections.JSONPointerSet(doc, "/foo/0", "BAR"); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(doc.(map[string]any)["foo"], []any{"BAR", "baz", "qux"}) {
+		t.Errorf("Set on list failed: %v", doc.(map[string]any)["foo"])
+	}
+}
+
+func TestJSONPointerSetMap(t *testing.T) {
+	doc, err := collections.JSONPointerSet(map[string]any{"obj": map[string]any{"x": map[string]any{}}}, "/obj/x/z", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := collections.JSONPointerGet(doc, "/obj/x/z"); v != 5 {
+		t.Error("Set on nested map failed")
+	}
+	if _, err := collections.JSONPointerSet(doc, "/missing/a", 1); err == nil {
+		t.Error("Set should not create intermediate objects")
+	}
+}
+
+func TestJSONPointerRemove(t *testing.T) {
+	var doc any = map[string]any{"foo": []any{"bar", "baz", "qux"}, "a/b": 1}
+	doc, err := collections.JSONPointerRemove(doc, "/foo/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc, err = collections.JSONPointerRemove(doc, "/a~1b"); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(doc.(map[string]any)["foo"], []any{"bar", "qux"}) {
+		t.Errorf("Remove from list failed: %v", doc.(map[string]any)["foo"])
+	}
+	if _, ok := doc.(map[string]any)["a/b"]; ok {
+		t.Error("Remove from map failed")
+	}
+	if _, err := collections.JSONPointerRemove(doc, "/foo/5"); err == nil {
+		t.Error("Remove of missing index should fail")
+	}
+}
@@ -0,0 +1,128 @@
+package collections
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// Change holds the old and new value of a modified entry.
+type Change[V any] struct {
+	Old V `json:"old"`
+	New V `json:"new"`
+}
+
+// ChangeSet describes the difference between two MapCollections.
+type ChangeSet[K comparable, V any] struct {
+	Added    *MapCollection[K, V]
+	Removed  *MapCollection[K, V]
+	Modified *MapCollection[K, Change[V]]
+}
+
+// Changes compares two MapCollections and returns the added, removed and modified entries.
+// Values are compared with equal if given, or reflect.DeepEqual otherwise.
+func Changes[K comparable, V any](oldMap, newMap *MapCollection[K, V], equal ...func(a, b V) bool) *ChangeSet[K, V] {
+	eq := func(a, b V) bool {
+		return reflect.DeepEqual(a, b)
+	}
+	if len(equal) > 0 && equal[0] != nil {
+		eq = equal[0]
+	}
+
+	cs := &ChangeSet[K, V]{
+		Added:    NewMap[K, V](nil),
+		Removed:  NewMap[K, V](nil),
+		Modified: NewMap[K, Change[V]](nil),
+	}
+	for _, k := range oldMap.keys {
+		before := oldMap.items[k]
+		after, exists := newMap.items[k]
+		if !exists {
+			cs.Removed.Put(k, before)
+		} else if !eq(before, after) {
+			cs.Modified.Put(k, Change[V]{Old: before, New: after})
+		}
+	}
+	for _, k := range newMap.keys {
+		if _, exists := oldMap.items[k]; !exists {
+			cs.Added.Put(k, newMap.items[k])
+		}
+	}
+	return cs
+}
+
+// ApplyChanges replays a changeset onto a copy of the map and returns it.
+func ApplyChanges[K comparable, V any](m *MapCollection[K, V], cs *ChangeSet[K, V]) *MapCollection[K, V] {
+	return cs.Apply(m)
+}
+
+// Apply replays the changeset onto a copy of the map and returns it.
+// Removed keys are forgotten, modified keys take their new value and added keys are appended.
+func (cs *ChangeSet[K, V]) Apply(m *MapCollection[K, V]) *MapCollection[K, V] {
+	result := m.Clone()
+	result.Forget(cs.Removed.keys...)
+	cs.Modified.Each(func(k K, change Change[V]) {
+		result.Put(k, change.New)
+	})
+	cs.Added.Each(func(k K, v V) {
+		result.Put(k, v)
+	})
+	return result
+}
+
+// Reverse returns a changeset that undoes this one.
+func (cs *ChangeSet[K, V]) Reverse() *ChangeSet[K, V] {
+	return &ChangeSet[K, V]{
+		Added:   cs.Removed.Clone(),
+		Removed: cs.Added.Clone(),
+		Modified: MapValues(cs.Modified, func(c Change[V], _ K) Change[V] {
+			return Change[V]{Old: c.New, New: c.Old}
+		}),
+	}
+}
+
+// IsEmpty determines if the changeset has no changes.
+func (cs *ChangeSet[K, V]) IsEmpty() bool {
+	return cs.Count() == 0
+}
+
+// Count returns the total number of changed keys.
+func (cs *ChangeSet[K, V]) Count() int {
+	return cs.Added.Count() + cs.Removed.Count() + cs.Modified.Count()
+}
+
+// changeSetJSON is the wire format of a ChangeSet.
+type changeSetJSON[K comparable, V any] struct {
+	Added    map[K]V         `json:"added"`
+	Removed  map[K]V         `json:"removed"`
+	Modified map[K]Change[V] `json:"modified"`
+}
+
+// MarshalJSON encodes the changeset as {"added": {...}, "removed": {...}, "modified": {key: {"old", "new"}}}.
+func (cs *ChangeSet[K, V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(changeSetJSON[K, V]{
+		Added:    cs.Added.items,
+		Removed:  cs.Removed.items,
+		Modified: cs.Modified.items,
+	})
+}
+
+// UnmarshalJSON decodes a changeset produced by MarshalJSON.
+func (cs *ChangeSet[K, V]) UnmarshalJSON(data []byte) error {
+	var decoded changeSetJSON[K, V]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	cs.Added = NewMap(decoded.Added)
+	cs.Removed = NewMap(decoded.Removed)
+	cs.Modified = NewMap(decoded.Modified)
+	return nil
+}
+
+// ToJSONString converts the changeset to a JSON string.
+func (cs *ChangeSet[K, V]) ToJSONString() string {
+	data, err := cs.MarshalJSON()
+	if err != nil {
+		return "{}"
+	}
+	return string(data)
+}
@@ -0,0 +1,80 @@
+package collections_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/qiuapeng921/collections"
+)
+
+func TestChanges(t *testing.T) {
+	old := collections.NewMapOrdered(map[string]any{"host": "a", "port": 80, "debug": true}, []string{"host", "port", "debug"})
+	new := collections.NewMapOrdered(map[string]any{"host": "b", "port": 80, "tls": true}, []string{"host", "port", "tls"})
+
+	cs := collections.Changes(old, new)
+	if cs.Added.Count() != 1 || !cs.Added.Has("tls") {
+		t.Error("Changes added failed")
+	}
+	if cs.Removed.Count() != 1 || !cs.Removed.Has("debug") {
+		t.Error("Changes removed failed")
+	}
+	if change := cs.Modified.Get("host"); cs.Modified.Count() != 1 || change.Old != "a" || change.New != "b" {
+		t.Error("Changes modified failed")
+	}
+	if cs.Count() != 3 || cs.IsEmpty() {
+		t.Error("Changes count failed")
+	}
+	if !collections.Changes(old, old.Clone()).IsEmpty() {
+		t.Error("Changes identical maps failed")
+	}
+}
+
+func TestChangesCustomEqual(t *testing.T) {
+	old := collections.NewMap(map[string]string{"name": "Alice"})
+	new := collections.NewMap(map[string]string{"name": "ALICE"})
+	cs := collections.Changes(old, new, strings.EqualFold)
+	if !cs.IsEmpty() {
+		t.Error("Changes custom equal failed")
+	}
+}
+
+func TestApplyChanges(t *testing.T) {
+	old := collections.NewMapOrdered(map[string]int{"a": 1, "b": 2, "c": 3}, []string{"a", "b", "c"})
+	new := collections.NewMapOrdered(map[string]int{"a": 1, "b": 20, "d": 4}, []string{"a", "b", "d"})
+	cs := collections.Changes(old, new)
+
+	applied := collections.ApplyChanges(old, cs)
+	if !collections.Changes(applied, new).IsEmpty() {
+		t.Errorf("ApplyChanges failed: %v", applied.All())
+	}
+	if old.Get("b") != 2 || !old.Has("c") {
+		t.Error("ApplyChanges mutated input")
+	}
+	if !collections.Changes(cs.Reverse().Apply(applied), old).IsEmpty() {
+		t.Error("ChangeSet Reverse failed")
+	}
+}
+
+func TestChangeSetJSON(t *testing.T) {
+	old := collections.NewMap(map[string]int{"a": 1, "b": 2})
+	new := collections.NewMap(map[string]int{"a": 5, "c": 3})
+	cs := collections.Changes(old, new)
+
+	data, err := json.Marshal(cs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"added":{"c":3},"removed":{"b":2},"modified":{"a":{"old":1,"new":5}}}`
+	if string(data) != expected {
+		t.Errorf("ChangeSet MarshalJSON failed: %s", data)
+	}
+
+	var decoded collections.ChangeSet[string, int]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Modified.Get("a").New != 5 || decoded.Added.Get("c") != 3 || decoded.Count() != 3 {
+		t.Error("ChangeSet UnmarshalJSON failed")
+	}
+}
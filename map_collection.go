@@ -1,6 +1,7 @@
 package collections
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
 	"slices"
@@ -412,6 +413,36 @@ func SortMapKeysDesc[K interface {
 	return result
 }
 
+// SortMapByValue sorts the collection stably by value.
+func SortMapByValue[K comparable, V cmp.Ordered](m *MapCollection[K, V]) *MapCollection[K, V] {
+	return m.SortUsing(func(_ K, a V, _ K, b V) int {
+		return cmp.Compare(a, b)
+	})
+}
+
+// SortMapByValueDesc sorts the collection stably by value in descending order.
+func SortMapByValueDesc[K comparable, V cmp.Ordered](m *MapCollection[K, V]) *MapCollection[K, V] {
+	return m.SortUsing(func(_ K, a V, _ K, b V) int {
+		return cmp.Compare(b, a)
+	})
+}
+
+// SortKeysUsing sorts the collection stably by keys using a comparison function.
+func (m *MapCollection[K, V]) SortKeysUsing(compare func(a, b K) int) *MapCollection[K, V] {
+	result := m.Clone()
+	slices.SortStableFunc(result.keys, compare)
+	return result
+}
+
+// SortUsing sorts the collection stably using a comparison function over both entries.
+func (m *MapCollection[K, V]) SortUsing(compare func(k1 K, v1 V, k2 K, v2 V) int) *MapCollection[K, V] {
+	result := m.Clone()
+	slices.SortStableFunc(result.keys, func(a, b K) int {
+		return compare(a, result.items[a], b, result.items[b])
+	})
+	return result
+}
+
 // GetOrPut gets a value or puts a default if not exists.
 func (m *MapCollection[K, V]) GetOrPut(key K, defaultValue V) V {
 	if v, exists := m.items[key]; exists {
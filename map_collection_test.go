@@ -1,6 +1,7 @@
 package collections_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/qiuapeng921/collections"
@@ -324,3 +325,42 @@ func TestMapCollectionEveryFalse(t *testing.T) {
 		t.Error("Every should return false")
 	}
 }
+
+func TestSortMapByValue(t *testing.T) {
+	revenue := collections.NewMapOrdered(
+		map[string]int{"books": 30, "games": 90, "music": 30, "toys": 50},
+		[]string{"books", "games", "music", "toys"},
+	)
+	asc := collections.SortMapByValue(revenue).Keys().All()
+	if strings.Join(asc, ",") != "books,music,toys,games" {
+		t.Errorf("SortMapByValue failed: %v", asc)
+	}
+	top := collections.SortMapByValueDesc(revenue).Take(2).Keys().All()
+	if strings.Join(top, ",") != "games,toys" {
+		t.Errorf("SortMapByValueDesc failed: %v", top)
+	}
+	if revenue.FirstKey() != "books" {
+		t.Error("SortMapByValue mutated receiver")
+	}
+}
+
+func TestMapCollectionSortKeysUsing(t *testing.T) {
+	m := collections.NewMapOrdered(map[string]int{"bb": 1, "a": 2, "ccc": 3, "dd": 4}, []string{"bb", "a", "ccc", "dd"})
+	sorted := m.SortKeysUsing(func(a, b string) int { return len(a) - len(b) }).Keys().All()
+	if strings.Join(sorted, ",") != "a,bb,dd,ccc" {
+		t.Errorf("SortKeysUsing failed: %v", sorted)
+	}
+}
+
+func TestMapCollectionSortUsing(t *testing.T) {
+	m := collections.NewMapOrdered(map[string]int{"x": 2, "y": 1, "z": 2}, []string{"x", "y", "z"})
+	sorted := m.SortUsing(func(k1 string, v1 int, k2 string, v2 int) int {
+		if v1 != v2 {
+			return v2 - v1
+		}
+		return strings.Compare(k2, k1)
+	}).Keys().All()
+	if strings.Join(sorted, ",") != "z,x,y" {
+		t.Errorf("SortUsing failed: %v", sorted)
+	}
+}
@@ -0,0 +1,141 @@
+package collections
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// MergeStrategy controls how MergeAll resolves keys present in more than one map.
+type MergeStrategy int
+
+const (
+	// MergeKeepLast keeps the value from the last map containing the key.
+	MergeKeepLast MergeStrategy = iota
+	// MergeKeepFirst keeps the value from the first map containing the key.
+	MergeKeepFirst
+	// MergeErrorOnConflict returns a ConflictException when a key has different values.
+	MergeErrorOnConflict
+)
+
+// ListMergeMode controls how MergeDeep combines nested Collections and Arr.MergeWith combines lists.
+type ListMergeMode int
+
+const (
+	// ListReplace replaces the left collection with the right one.
+	ListReplace ListMergeMode = iota
+	// ListAppend appends the right collection's items to the left one.
+	ListAppend
+	// ListMergeByIndex merges items at the same index and appends the rest of the longer right one.
+	ListMergeByIndex
+)
+
+// MergeWith merges another MapCollection, calling resolver for keys present in both.
+func (m *MapCollection[K, V]) MergeWith(other *MapCollection[K, V], resolver func(key K, left, right V) V) *MapCollection[K, V] {
+	result := m.Clone()
+	for _, k := range other.keys {
+		right := other.items[k]
+		if left, exists := result.items[k]; exists {
+			right = resolver(k, left, right)
+		}
+		result.Put(k, right)
+	}
+	return result
+}
+
+// MergeAll merges maps from left to right using the given strategy.
+// With MergeErrorOnConflict, equal values (by reflect.DeepEqual) are not treated as conflicts.
+func MergeAll[K comparable, V any](strategy MergeStrategy, maps ...*MapCollection[K, V]) (*MapCollection[K, V], error) {
+	result := NewMap[K, V](nil)
+	var conflict error
+	for _, other := range maps {
+		result = result.MergeWith(other, func(k K, left, right V) V {
+			switch strategy {
+			case MergeKeepFirst:
+				return left
+			case MergeErrorOnConflict:
+				if conflict == nil && !reflect.DeepEqual(left, right) {
+					conflict = &ConflictException{Message: fmt.Sprintf("conflicting values for key %v: %v and %v", k, left, right)}
+				}
+			}
+			return right
+		})
+		if conflict != nil {
+			return nil, conflict
+		}
+	}
+	return result, nil
+}
+
+// MergeCollect merges maps from left to right, collecting every value for a key into a slice.
+func MergeCollect[K comparable, V any](maps ...*MapCollection[K, V]) *MapCollection[K, []V] {
+	result := NewMap[K, []V](nil)
+	for _, m := range maps {
+		for _, k := range m.keys {
+			result.Put(k, append(result.items[k], m.items[k]))
+		}
+	}
+	return result
+}
+
+// deepMerger is implemented by collection types that MergeDeep can descend into.
+type deepMerger interface {
+	mergeDeepValue(other any, mode ListMergeMode) (any, bool)
+}
+
+// MergeDeep recursively merges another MapCollection. Values that are both *MapCollection
+// are merged key by key, values that are both *Collection are combined according to mode,
+// and anything else is replaced by the right value. Neither input is mutated.
+func (m *MapCollection[K, V]) MergeDeep(other *MapCollection[K, V], mode ListMergeMode) *MapCollection[K, V] {
+	return m.MergeWith(other, func(_ K, left, right V) V {
+		if merged, ok := mergeDeepAny(left, right, mode); ok {
+			if v, ok := merged.(V); ok {
+				return v
+			}
+		}
+		return right
+	})
+}
+
+// mergeDeepAny merges two values if they are collections of the same type.
+func mergeDeepAny(left, right any, mode ListMergeMode) (any, bool) {
+	if merger, ok := left.(deepMerger); ok {
+		return merger.mergeDeepValue(right, mode)
+	}
+	return nil, false
+}
+
+func (m *MapCollection[K, V]) mergeDeepValue(other any, mode ListMergeMode) (any, bool) {
+	right, ok := other.(*MapCollection[K, V])
+	if !ok || m == nil || right == nil {
+		return nil, false
+	}
+	return m.MergeDeep(right, mode), true
+}
+
+func (c *Collection[T]) mergeDeepValue(other any, mode ListMergeMode) (any, bool) {
+	right, ok := other.(*Collection[T])
+	if !ok || c == nil || right == nil {
+		return nil, false
+	}
+	switch mode {
+	case ListAppend:
+		return c.Merge(right), true
+	case ListMergeByIndex:
+		result := slices.Clone(c.items)
+		for i, item := range right.items {
+			if i >= len(result) {
+				result = append(result, item)
+				continue
+			}
+			if merged, ok := mergeDeepAny(result[i], item, mode); ok {
+				if v, ok := merged.(T); ok {
+					item = v
+				}
+			}
+			result[i] = item
+		}
+		return New(result), true
+	}
+	return New(slices.Clone(right.items)), true
+}
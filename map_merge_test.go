@@ -0,0 +1,113 @@
+package collections_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/qiuapeng921/collections"
+)
+
+func TestMapCollectionMergeWith(t *testing.T) {
+	a := collections.NewMapOrdered(map[string]int{"x": 1, "y": 2}, []string{"x", "y"})
+	b := collections.NewMapOrdered(map[string]int{"y": 10, "z": 3}, []string{"y", "z"})
+
+	merged := a.MergeWith(b, func(_ string, left, right int) int { return left + right })
+	if merged.Get("y") != 12 || merged.Get("z") != 3 || merged.Count() != 3 {
+		t.Error("MergeWith failed")
+	}
+	if got := merged.Keys().All(); !slices.Equal(got, []string{"x", "y", "z"}) {
+		t.Errorf("MergeWith order failed: %v", got)
+	}
+	if a.Get("y") != 2 || a.Count() != 2 {
+		t.Error("MergeWith mutated receiver")
+	}
+}
+
+func TestMergeAllStrategies(t *testing.T) {
+	a := collections.NewMap(map[string]int{"x": 1})
+	b := collections.NewMap(map[string]int{"x": 2, "y": 3})
+	c := collections.NewMap(map[string]int{"x": 1})
+
+	last, _ := collections.MergeAll(collections.MergeKeepLast, a, b)
+	first, _ := collections.MergeAll(collections.MergeKeepFirst, a, b)
+	if last.Get("x") != 2 || first.Get("x") != 1 || first.Get("y") != 3 {
+		t.Error("MergeAll keep-first/keep-last failed")
+	}
+
+	_, err := collections.MergeAll(collections.MergeErrorOnConflict, a, b)
+	var conflict *collections.ConflictException
+	if !errors.As(err, &conflict) {
+		t.Error("MergeAll should report conflicts")
+	}
+	if _, err := collections.MergeAll(collections.MergeErrorOnConflict, a, c); err != nil {
+		t.Error("MergeAll equal values should not conflict")
+	}
+}
+
+func TestMergeCollect(t *testing.T) {
+	a := collections.NewMap(map[string]int{"x": 1})
+	b := collections.NewMap(map[string]int{"x": 2, "y": 3})
+	collected := collections.MergeCollect(a, b)
+	if !slices.Equal(collected.Get("x"), []int{1, 2}) || !slices.Equal(collected.Get("y"), []int{3}) {
+		t.Error("MergeCollect failed")
+	}
+}
+
+func TestMapCollectionMergeDeep(t *testing.T) {
+	defaults := collections.NewMap(map[string]any{
+		"db":   collections.NewMap(map[string]any{"host": "localhost", "port": 5432}),
+		"tags": collections.New([]any{"a"}),
+		"name": "app",
+	})
+	override := collections.NewMap(map[string]any{
+		"db":   collections.NewMap(map[string]any{"host": "db.internal"}),
+		"tags": collections.New([]any{"b"}),
+		"name": "service",
+	})
+
+	merged := defaults.MergeDeep(override, collections.ListAppend)
+	db := merged.Get("db").(*collections.MapCollection[string, any])
+	if db.Get("host") != "db.internal" || db.Get("port") != 5432 {
+		t.Error("MergeDeep nested map failed")
+	}
+	if tags := merged.Get("tags").(*collections.Collection[any]); tags.Count() != 2 {
+		t.Error("MergeDeep append failed")
+	}
+	if merged.Get("name") != "service" {
+		t.Error("MergeDeep scalar failed")
+	}
+
+	replaced := defaults.MergeDeep(override, collections.ListReplace)
+	if tags := replaced.Get("tags").(*collections.Collection[any]); tags.Count() != 1 || tags.First() != "b" {
+		t.Error("MergeDeep replace failed")
+	}
+
+	original := defaults.Get("db").(*collections.MapCollection[string, any])
+	if original.Get("host") != "localhost" || defaults.Get("tags").(*collections.Collection[any]).Count() != 1 {
+		t.Error("MergeDeep mutated input")
+	}
+}
+
+func TestMapCollectionMergeDeepByIndex(t *testing.T) {
+	left := collections.NewMap(map[string]any{
+		"servers": collections.New([]any{
+			collections.NewMap(map[string]any{"host": "s1", "weight": 1}),
+			collections.NewMap(map[string]any{"host": "s2", "weight": 1}),
+		}),
+	})
+	right := collections.NewMap(map[string]any{
+		"servers": collections.New([]any{
+			collections.NewMap(map[string]any{"weight": 5}),
+		}),
+	})
+
+	servers := left.MergeDeep(right, collections.ListMergeByIndex).Get("servers").(*collections.Collection[any])
+	if servers.Count() != 2 {
+		t.Fatalf("Expected 2 servers, got %d", servers.Count())
+	}
+	first := servers.First().(*collections.MapCollection[string, any])
+	if first.Get("host") != "s1" || first.Get("weight") != 5 {
+		t.Errorf("Merge by index failed: %v", first.All())
+	}
+}
@@ -0,0 +1,236 @@
+package collections
+
+import (
+	"cmp"
+	"slices"
+)
+
+// subset returns a new MapCollection with the given keys, in the given order.
+func (m *MapCollection[K, V]) subset(keys []K) *MapCollection[K, V] {
+	result := make(map[K]V, len(keys))
+	for _, k := range keys {
+		result[k] = m.items[k]
+	}
+	return NewMapOrdered(result, keys)
+}
+
+// Slice returns a slice of the collection in key order.
+func (m *MapCollection[K, V]) Slice(offset int, length ...int) *MapCollection[K, V] {
+	if offset < 0 {
+		offset = max(0, len(m.keys)+offset)
+	}
+	if offset >= len(m.keys) {
+		return NewMap[K, V](nil)
+	}
+
+	end := len(m.keys)
+	if len(length) > 0 {
+		end = max(offset, min(offset+length[0], len(m.keys)))
+	}
+	return m.subset(m.keys[offset:end])
+}
+
+// Take returns the first n entries, or the last n if n is negative.
+func (m *MapCollection[K, V]) Take(n int) *MapCollection[K, V] {
+	if n < 0 {
+		return m.Slice(n)
+	}
+	return m.Slice(0, n)
+}
+
+// Skip returns all entries except the first n.
+func (m *MapCollection[K, V]) Skip(n int) *MapCollection[K, V] {
+	return m.Slice(n)
+}
+
+// TakeWhile takes entries while the condition is true.
+func (m *MapCollection[K, V]) TakeWhile(predicate func(V, K) bool) *MapCollection[K, V] {
+	for i, k := range m.keys {
+		if !predicate(m.items[k], k) {
+			return m.Slice(0, i)
+		}
+	}
+	return m.Clone()
+}
+
+// SkipWhile skips entries while the condition is true.
+func (m *MapCollection[K, V]) SkipWhile(predicate func(V, K) bool) *MapCollection[K, V] {
+	for i, k := range m.keys {
+		if !predicate(m.items[k], k) {
+			return m.Slice(i)
+		}
+	}
+	return NewMap[K, V](nil)
+}
+
+// Chunk splits the collection into chunks of the given size.
+func (m *MapCollection[K, V]) Chunk(size int) []*MapCollection[K, V] {
+	if size <= 0 {
+		return []*MapCollection[K, V]{}
+	}
+
+	chunks := make([]*MapCollection[K, V], 0)
+	for i := 0; i < len(m.keys); i += size {
+		end := min(i+size, len(m.keys))
+		chunks = append(chunks, m.subset(m.keys[i:end]))
+	}
+	return chunks
+}
+
+// Partition splits the collection into two based on a predicate.
+func (m *MapCollection[K, V]) Partition(predicate func(V, K) bool) (*MapCollection[K, V], *MapCollection[K, V]) {
+	pass := make([]K, 0)
+	fail := make([]K, 0)
+	for _, k := range m.keys {
+		if predicate(m.items[k], k) {
+			pass = append(pass, k)
+		} else {
+			fail = append(fail, k)
+		}
+	}
+	return m.subset(pass), m.subset(fail)
+}
+
+// FilterKeys returns entries whose keys pass the predicate.
+func (m *MapCollection[K, V]) FilterKeys(predicate func(K) bool) *MapCollection[K, V] {
+	return m.Filter(func(_ V, k K) bool {
+		return predicate(k)
+	})
+}
+
+// RejectKeys returns entries whose keys don't pass the predicate.
+func (m *MapCollection[K, V]) RejectKeys(predicate func(K) bool) *MapCollection[K, V] {
+	return m.Filter(func(_ V, k K) bool {
+		return !predicate(k)
+	})
+}
+
+// Search returns the first key whose entry passes the predicate.
+func (m *MapCollection[K, V]) Search(predicate func(V, K) bool) (K, bool) {
+	for _, k := range m.keys {
+		if predicate(m.items[k], k) {
+			return k, true
+		}
+	}
+	var zero K
+	return zero, false
+}
+
+// Reverse returns a new collection with entries in reverse order.
+func (m *MapCollection[K, V]) Reverse() *MapCollection[K, V] {
+	keys := slices.Clone(m.keys)
+	slices.Reverse(keys)
+	return m.subset(keys)
+}
+
+// Unless applies the callback if the condition is false.
+func (m *MapCollection[K, V]) Unless(condition bool, callback func(*MapCollection[K, V]) *MapCollection[K, V]) *MapCollection[K, V] {
+	return m.When(!condition, callback)
+}
+
+// WhenEmpty applies the callback if the collection is empty.
+func (m *MapCollection[K, V]) WhenEmpty(callback func(*MapCollection[K, V]) *MapCollection[K, V]) *MapCollection[K, V] {
+	return m.When(m.IsEmpty(), callback)
+}
+
+// WhenNotEmpty applies the callback if the collection is not empty.
+func (m *MapCollection[K, V]) WhenNotEmpty(callback func(*MapCollection[K, V]) *MapCollection[K, V]) *MapCollection[K, V] {
+	return m.When(m.IsNotEmpty(), callback)
+}
+
+// SortMapBy sorts the collection stably by a key function over each entry.
+func SortMapBy[K comparable, V any, S cmp.Ordered](m *MapCollection[K, V], keyFn func(V, K) S) *MapCollection[K, V] {
+	keys := slices.Clone(m.keys)
+	slices.SortStableFunc(keys, func(a, b K) int {
+		return cmp.Compare(keyFn(m.items[a], a), keyFn(m.items[b], b))
+	})
+	return m.subset(keys)
+}
+
+// SortMapByDesc sorts the collection stably by a key function in descending order.
+func SortMapByDesc[K comparable, V any, S cmp.Ordered](m *MapCollection[K, V], keyFn func(V, K) S) *MapCollection[K, V] {
+	keys := slices.Clone(m.keys)
+	slices.SortStableFunc(keys, func(a, b K) int {
+		return cmp.Compare(keyFn(m.items[b], b), keyFn(m.items[a], a))
+	})
+	return m.subset(keys)
+}
+
+// MapKeys applies a callback to each key. Later entries win when new keys collide.
+func MapKeys[K comparable, V any, K2 comparable](m *MapCollection[K, V], callback func(V, K) K2) *MapCollection[K2, V] {
+	return MapWithKeysMap(m, func(v V, k K) (K2, V) {
+		return callback(v, k), v
+	})
+}
+
+// MapWithKeysMap maps each entry to a new key-value pair. Later entries win when new keys collide.
+func MapWithKeysMap[K comparable, V any, K2 comparable, V2 any](m *MapCollection[K, V], callback func(V, K) (K2, V2)) *MapCollection[K2, V2] {
+	result := make(map[K2]V2)
+	keys := make([]K2, 0)
+	for _, k := range m.keys {
+		k2, v2 := callback(m.items[k], k)
+		if _, exists := result[k2]; !exists {
+			keys = append(keys, k2)
+		}
+		result[k2] = v2
+	}
+	return NewMapOrdered(result, keys)
+}
+
+// GroupMapBy groups entries by a function of their value and key.
+func GroupMapBy[K comparable, V any, G comparable](m *MapCollection[K, V], keyFn func(V, K) G) *MapCollection[G, *MapCollection[K, V]] {
+	result := make(map[G]*MapCollection[K, V])
+	keys := make([]G, 0)
+	for _, k := range m.keys {
+		g := keyFn(m.items[k], k)
+		if _, exists := result[g]; !exists {
+			result[g] = NewMap[K, V](nil)
+			keys = append(keys, g)
+		}
+		result[g].Put(k, m.items[k])
+	}
+	return NewMapOrdered(result, keys)
+}
+
+// SumMap returns the sum of all values.
+func SumMap[K comparable, V Numeric](m *MapCollection[K, V]) V {
+	var sum V
+	for _, k := range m.keys {
+		sum += m.items[k]
+	}
+	return sum
+}
+
+// AvgMap returns the average of all values.
+func AvgMap[K comparable, V Numeric](m *MapCollection[K, V]) float64 {
+	if m.IsEmpty() {
+		return 0
+	}
+	return float64(SumMap(m)) / float64(m.Count())
+}
+
+// MinMap returns the key and value of the smallest value.
+func MinMap[K comparable, V cmp.Ordered](m *MapCollection[K, V]) (K, V) {
+	return extremeMap(m, -1)
+}
+
+// MaxMap returns the key and value of the largest value.
+func MaxMap[K comparable, V cmp.Ordered](m *MapCollection[K, V]) (K, V) {
+	return extremeMap(m, 1)
+}
+
+// extremeMap returns the first entry whose value compares as sign against all others.
+func extremeMap[K comparable, V cmp.Ordered](m *MapCollection[K, V], sign int) (K, V) {
+	if m.IsEmpty() {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV
+	}
+	bestKey := m.keys[0]
+	for _, k := range m.keys[1:] {
+		if cmp.Compare(m.items[k], m.items[bestKey]) == sign {
+			bestKey = k
+		}
+	}
+	return bestKey, m.items[bestKey]
+}
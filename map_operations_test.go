@@ -0,0 +1,153 @@
+package collections_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/qiuapeng921/collections"
+)
+
+func TestMapCollectionSliceTakeSkip(t *testing.T) {
+	m := collections.NewMapOrdered(map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}, []string{"a", "b", "c", "d"})
+	if got := m.Take(2).Keys().All(); !slices.Equal(got, []string{"a", "b"}) {
+		t.Errorf("Take failed: %v", got)
+	}
+	if got := m.Take(-1).Keys().All(); !slices.Equal(got, []string{"d"}) {
+		t.Errorf("Take negative failed: %v", got)
+	}
+	if got := m.Skip(3).Keys().All(); !slices.Equal(got, []string{"d"}) {
+		t.Errorf("Skip failed: %v", got)
+	}
+	if got := m.Slice(1, 2).Keys().All(); !slices.Equal(got, []string{"b", "c"}) {
+		t.Errorf("Slice failed: %v", got)
+	}
+	if m.Slice(10).Count() != 0 {
+		t.Error("Slice out of range failed")
+	}
+}
+
+func TestMapCollectionTakeSkipWhile(t *testing.T) {
+	m := collections.NewMapOrdered(map[string]int{"a": 90, "b": 70, "c": 85}, []string{"a", "b", "c"})
+	if m.TakeWhile(func(v int, _ string) bool { return v > 80 }).Count() != 1 {
+		t.Error("TakeWhile failed")
+	}
+	if m.SkipWhile(func(v int, _ string) bool { return v > 80 }).FirstKey() != "b" {
+		t.Error("SkipWhile failed")
+	}
+}
+
+func TestMapCollectionChunk(t *testing.T) {
+	m := collections.NewMapOrdered(map[string]int{"a": 1, "b": 2, "c": 3}, []string{"a", "b", "c"})
+	chunks := m.Chunk(2)
+	if len(chunks) != 2 || chunks[1].FirstKey() != "c" {
+		t.Error("Chunk failed")
+	}
+}
+
+func TestMapCollectionPartition(t *testing.T) {
+	m := collections.NewMapOrdered(map[string]int{"a": 90, "b": 70, "c": 85}, []string{"a", "b", "c"})
+	pass, fail := m.Partition(func(v int, _ string) bool { return v >= 80 })
+	if pass.Count() != 2 || fail.FirstKey() != "b" {
+		t.Error("Partition failed")
+	}
+}
+
+func TestMapCollectionKeyFilters(t *testing.T) {
+	m := collections.NewMapOrdered(map[string]int{"alice": 1, "bob": 2}, []string{"alice", "bob"})
+	if m.RejectKeys(func(k string) bool { return strings.HasPrefix(k, "a") }).Has("alice") {
+		t.Error("RejectKeys failed")
+	}
+	if m.FilterKeys(func(k string) bool { return len(k) == 3 }).FirstKey() != "bob" {
+		t.Error("FilterKeys failed")
+	}
+}
+
+func TestMapCollectionSearch(t *testing.T) {
+	m := collections.NewMapOrdered(map[string]int{"a": 90, "b": 70, "c": 70}, []string{"a", "b", "c"})
+	if k, ok := m.Search(func(v int, _ string) bool { return v == 70 }); !ok || k != "b" {
+		t.Error("Search failed")
+	}
+	if _, ok := m.Search(func(v int, _ string) bool { return v > 100 }); ok {
+		t.Error("Search missing failed")
+	}
+}
+
+func TestMapCollectionReverse(t *testing.T) {
+	m := collections.NewMapOrdered(map[string]int{"a": 1, "b": 2}, []string{"a", "b"})
+	if m.Reverse().FirstKey() != "b" {
+		t.Error("Reverse failed")
+	}
+}
+
+func TestMapCollectionConditions(t *testing.T) {
+	m := collections.NewMap(map[string]int{"a": 1})
+	clear := func(*collections.MapCollection[string, int]) *collections.MapCollection[string, int] {
+		return collections.NewMap[string, int](nil)
+	}
+	if m.Unless(true, clear).Count() != 1 || m.Unless(false, clear).Count() != 0 {
+		t.Error("Unless failed")
+	}
+	if m.WhenEmpty(clear).Count() != 1 || m.WhenNotEmpty(clear).Count() != 0 {
+		t.Error("WhenEmpty/WhenNotEmpty failed")
+	}
+}
+
+func TestSortMapBy(t *testing.T) {
+	m := collections.NewMapOrdered(map[string]int{"a": 90, "b": 70, "c": 85, "d": 70}, []string{"a", "b", "c", "d"})
+	asc := collections.SortMapBy(m, func(v int, _ string) int { return v })
+	if got := asc.Keys().All(); !slices.Equal(got, []string{"b", "d", "c", "a"}) {
+		t.Errorf("SortMapBy failed: %v", got)
+	}
+	desc := collections.SortMapByDesc(m, func(v int, _ string) int { return v })
+	if got := desc.Keys().All(); !slices.Equal(got, []string{"a", "c", "b", "d"}) {
+		t.Errorf("SortMapByDesc failed: %v", got)
+	}
+}
+
+func TestMapKeys(t *testing.T) {
+	m := collections.NewMapOrdered(map[string]int{"alice": 90, "bob": 70}, []string{"alice", "bob"})
+	upper := collections.MapKeys(m, func(_ int, k string) string { return strings.ToUpper(k) })
+	if upper.Get("ALICE") != 90 || upper.FirstKey() != "ALICE" {
+		t.Error("MapKeys failed")
+	}
+}
+
+func TestMapWithKeysMap(t *testing.T) {
+	m := collections.NewMapOrdered(map[string]int{"a": 90, "b": 70, "c": 70}, []string{"a", "b", "c"})
+	swapped := collections.MapWithKeysMap(m, func(v int, k string) (int, string) { return v, k })
+	if swapped.Count() != 2 || swapped.Get(70) != "c" {
+		t.Error("MapWithKeysMap failed")
+	}
+}
+
+func TestGroupMapBy(t *testing.T) {
+	m := collections.NewMapOrdered(map[string]int{"a": 90, "b": 70, "c": 85}, []string{"a", "b", "c"})
+	groups := collections.GroupMapBy(m, func(v int, _ string) bool { return v >= 80 })
+	if groups.Get(true).Count() != 2 || groups.Get(false).FirstKey() != "b" {
+		t.Error("GroupMapBy failed")
+	}
+}
+
+func TestMapAggregates(t *testing.T) {
+	m := collections.NewMap(map[string]int{"a": 90, "b": 70, "c": 80})
+	if collections.SumMap(m) != 240 || collections.AvgMap(m) != 80 {
+		t.Error("SumMap/AvgMap failed")
+	}
+	if k, v := collections.MinMap(m); k != "b" || v != 70 {
+		t.Error("MinMap failed")
+	}
+	if k, v := collections.MaxMap(m); k != "a" || v != 90 {
+		t.Error("MaxMap failed")
+	}
+}
+
+func TestMapAggregatesEmpty(t *testing.T) {
+	empty := collections.NewMap[string, int](nil)
+	if collections.AvgMap(empty) != 0 {
+		t.Error("AvgMap empty failed")
+	}
+	if k, _ := collections.MaxMap(empty); k != "" {
+		t.Error("MaxMap empty failed")
+	}
+}
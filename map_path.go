@@ -0,0 +1,125 @@
+package collections
+
+import (
+	"sort"
+	"strings"
+)
+
+// GetPath retrieves a value from a MapCollection using dot notation.
+// Nested map[string]any, *MapCollection[string, any], []any and *Collection[any] values
+// are traversed; list elements are addressed by numeric segments such as "users.0.name".
+func GetPath(m *MapCollection[string, any], path string, defaultValue ...any) any {
+	if v, ok := lookupPath(m, path); ok {
+		return v
+	}
+	if len(defaultValue) > 0 {
+		return defaultValue[0]
+	}
+	return nil
+}
+
+// HasPath determines if all the paths exist in a MapCollection.
+func HasPath(m *MapCollection[string, any], paths ...string) bool {
+	for _, path := range paths {
+		if _, ok := lookupPath(m, path); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// PutPath sets a value in a MapCollection using dot notation, creating nested maps as needed.
+func PutPath(m *MapCollection[string, any], path string, value any) *MapCollection[string, any] {
+	pathSet(m, splitPath(path), value)
+	return m
+}
+
+// ForgetPath removes values from a MapCollection using dot notation.
+func ForgetPath(m *MapCollection[string, any], paths ...string) *MapCollection[string, any] {
+	for _, path := range paths {
+		if m.Has(path) {
+			m.Forget(path)
+			continue
+		}
+		pathForget(m, splitPath(path))
+	}
+	return m
+}
+
+// DotMap flattens a MapCollection into a single level with dot notation keys.
+// Nested maps are flattened; lists and empty maps are kept as values, as in Arr.Dot.
+func DotMap(m *MapCollection[string, any]) *MapCollection[string, any] {
+	result := NewMap[string, any](nil)
+	dotInto(result, m, "")
+	return result
+}
+
+// UndotMap expands dot notation keys into nested maps, rebuilding maps keyed 0 to n-1 as
+// lists as Arr.Undot does. Top-level keys keep the order in which they first appear.
+func UndotMap(m *MapCollection[string, any]) *MapCollection[string, any] {
+	items := Arr.UndotWith(m.items, DotOptions{FlattenLists: true})
+	keys := make([]string, 0, len(items))
+	seen := make(map[string]bool, len(items))
+	for _, k := range m.keys {
+		first, _, _ := strings.Cut(k, ".")
+		if !seen[first] {
+			seen[first] = true
+			keys = append(keys, first)
+		}
+	}
+	return NewMapOrdered(items, keys)
+}
+
+// lookupPath checks for a literal key before walking the dot-notation path.
+func lookupPath(m *MapCollection[string, any], path string) (any, bool) {
+	if path == "" {
+		return m, true
+	}
+	if v, ok := m.items[path]; ok {
+		return v, true
+	}
+	return pathGet(m, splitPath(path))
+}
+
+// dotInto flattens a nested map value into result under prefix.
+func dotInto(result *MapCollection[string, any], value any, prefix string) {
+	var (
+		keys  []string
+		child func(string) any
+	)
+	switch v := value.(type) {
+	case *MapCollection[string, any]:
+		keys, child = v.keys, v.Get
+	case map[string]any:
+		keys = make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		child = func(k string) any { return v[k] }
+	}
+
+	for _, k := range keys {
+		fullKey := k
+		if prefix != "" {
+			fullKey = prefix + "." + k
+		}
+		next := child(k)
+		if isNestedMap(next) {
+			dotInto(result, next, fullKey)
+		} else {
+			result.Put(fullKey, next)
+		}
+	}
+}
+
+// isNestedMap determines if a value is a non-empty map that DotMap should flatten.
+func isNestedMap(value any) bool {
+	switch v := value.(type) {
+	case map[string]any:
+		return len(v) > 0
+	case *MapCollection[string, any]:
+		return v != nil && v.Count() > 0
+	}
+	return false
+}
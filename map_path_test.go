@@ -0,0 +1,144 @@
+package collections_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/qiuapeng921/collections"
+)
+
+func TestGetPathNestedMap(t *testing.T) {
+	m := collections.NewMap(map[string]any{"db": map[string]any{"host": "localhost"}})
+	if collections.GetPath(m, "db.host") != "localhost" {
+		t.Error("GetPath nested map failed")
+	}
+}
+
+func TestGetPathListIndex(t *testing.T) {
+	m := collections.NewMap(map[string]any{
+		"replicas": []any{map[string]any{"host": "r1"}, map[string]any{"host": "r2"}},
+	})
+	if collections.GetPath(m, "replicas.1.host") != "r2" {
+		t.Error("GetPath list index failed")
+	}
+	if collections.GetPath(m, "replicas.5.host", "none") != "none" {
+		t.Error("GetPath default failed")
+	}
+}
+
+func TestGetPathNestedMapCollection(t *testing.T) {
+	m := collections.NewMap(map[string]any{"cache": collections.NewMap(map[string]any{"ttl": 60})})
+	if collections.GetPath(m, "cache.ttl") != 60 {
+		t.Error("GetPath nested MapCollection failed")
+	}
+}
+
+func TestGetPathLiteralKey(t *testing.T) {
+	m := collections.NewMap(map[string]any{"a.b": "literal"})
+	if collections.GetPath(m, "a.b") != "literal" {
+		t.Error("GetPath literal key failed")
+	}
+}
+
+func TestHasPath(t *testing.T) {
+	m := collections.NewMap(map[string]any{
+		"db":    map[string]any{"host": "localhost"},
+		"cache": collections.NewMap(map[string]any{"ttl": 60}),
+	})
+	if !collections.HasPath(m, "db.host", "cache.ttl") || collections.HasPath(m, "db.user") {
+		t.Error("HasPath failed")
+	}
+}
+
+func TestPutPath(t *testing.T) {
+	m := collections.NewMap(map[string]any{
+		"db":    map[string]any{"host": "localhost"},
+		"cache": collections.NewMap(map[string]any{"ttl": 60}),
+	})
+	collections.PutPath(m, "db.port", 5432)
+	collections.PutPath(m, "cache.driver", "redis")
+	if collections.GetPath(m, "db.port") != 5432 || collections.GetPath(m, "cache.driver") != "redis" {
+		t.Error("PutPath failed")
+	}
+}
+
+func TestPutPathListElement(t *testing.T) {
+	m := collections.NewMap(map[string]any{"replicas": []any{map[string]any{"host": "r1"}}})
+	collections.PutPath(m, "replicas.0.host", "primary")
+	if collections.GetPath(m, "replicas.0.host") != "primary" {
+		t.Error("PutPath list element failed")
+	}
+}
+
+func TestPutPathCreatesIntermediates(t *testing.T) {
+	m := collections.NewMap[string, any](nil)
+	collections.PutPath(m, "log.level", "debug")
+	if _, ok := m.Get("log").(map[string]any); !ok || collections.GetPath(m, "log.level") != "debug" {
+		t.Error("PutPath intermediate creation failed")
+	}
+}
+
+func TestForgetPath(t *testing.T) {
+	m := collections.NewMap(map[string]any{
+		"db":    map[string]any{"host": "localhost", "port": 5432},
+		"cache": collections.NewMap(map[string]any{"ttl": 60}),
+		"a.b":   "literal",
+	})
+	collections.ForgetPath(m, "db.host", "cache.ttl", "a.b", "missing.key")
+	if collections.HasPath(m, "db.host") || collections.HasPath(m, "cache.ttl") || m.Has("a.b") {
+		t.Error("ForgetPath failed")
+	}
+	if !collections.HasPath(m, "db.port") {
+		t.Error("ForgetPath removed too much")
+	}
+}
+
+func TestDotMap(t *testing.T) {
+	m := collections.NewMapOrdered(map[string]any{
+		"app": map[string]any{"name": "demo", "env": "prod"},
+		"db":  collections.NewMap(map[string]any{"port": 5432}),
+		"ids": []any{1, 2},
+	}, []string{"app", "db", "ids"})
+
+	dot := collections.DotMap(m)
+	if dot.Count() != 4 || dot.Get("app.name") != "demo" || dot.Get("db.port") != 5432 {
+		t.Errorf("DotMap failed: %v", dot.All())
+	}
+	if keys := dot.Keys().All(); keys[0] != "app.env" || keys[3] != "ids" {
+		t.Errorf("DotMap order failed: %v", keys)
+	}
+}
+
+func TestDotMapEmpty(t *testing.T) {
+	if collections.DotMap(collections.NewMap[string, any](nil)).Count() != 0 {
+		t.Error("DotMap empty failed")
+	}
+}
+
+func TestUndotMap(t *testing.T) {
+	dot := collections.NewMap(map[string]any{"app.env": "prod", "db.port": 5432})
+	undot := collections.UndotMap(dot)
+	if collections.GetPath(undot, "app.env") != "prod" || collections.GetPath(undot, "db.port") != 5432 {
+		t.Error("UndotMap failed")
+	}
+}
+
+func TestUndotMapKeyOrder(t *testing.T) {
+	listFirst := collections.NewMapOrdered(map[string]any{"a.0": 1, "a.b": 2}, []string{"a.0", "a.b"})
+	mapFirst := collections.NewMapOrdered(map[string]any{"a.0": 1, "a.b": 2}, []string{"a.b", "a.0"})
+	want := map[string]any{"a": map[string]any{"0": 1, "b": 2}}
+	if got := collections.UndotMap(listFirst).All(); !reflect.DeepEqual(got, want) {
+		t.Errorf("UndotMap list key first failed: %v", got)
+	}
+	if got := collections.UndotMap(mapFirst).All(); !reflect.DeepEqual(got, want) {
+		t.Errorf("UndotMap map key first failed: %v", got)
+	}
+}
+
+func TestUndotMapLists(t *testing.T) {
+	dot := collections.NewMapOrdered(map[string]any{"z": 1, "ids.1": "b", "ids.0": "a"}, []string{"z", "ids.1", "ids.0"})
+	undot := collections.UndotMap(dot)
+	if !reflect.DeepEqual(undot.Get("ids"), []any{"a", "b"}) || !reflect.DeepEqual(undot.Keys().All(), []string{"z", "ids"}) {
+		t.Errorf("UndotMap lists failed: %v %v", undot.All(), undot.Keys().All())
+	}
+}
@@ -0,0 +1,215 @@
+package collections
+
+import (
+	"slices"
+)
+
+// MultiMap associates each key with multiple values.
+// A list-valued MultiMap keeps duplicate values in insertion order;
+// a set-valued MultiMap ignores a value that is already stored under the key.
+type MultiMap[K comparable, V comparable] struct {
+	items map[K][]V
+	keys  []K // Maintains insertion order
+	set   bool
+}
+
+// NewMultiMap creates a new list-valued MultiMap.
+func NewMultiMap[K comparable, V comparable]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{items: make(map[K][]V), keys: make([]K, 0)}
+}
+
+// NewSetMultiMap creates a new set-valued MultiMap.
+func NewSetMultiMap[K comparable, V comparable]() *MultiMap[K, V] {
+	m := NewMultiMap[K, V]()
+	m.set = true
+	return m
+}
+
+// MultiMapFromDictionary creates a list-valued MultiMap from the output of MapToDictionary.
+func MultiMapFromDictionary[K comparable, V comparable](m *MapCollection[K, []V]) *MultiMap[K, V] {
+	result := NewMultiMap[K, V]()
+	for _, k := range m.keys {
+		result.PutAll(k, m.items[k]...)
+	}
+	return result
+}
+
+// IsSetValued determines if the MultiMap stores distinct values per key.
+func (m *MultiMap[K, V]) IsSetValued() bool {
+	return m.set
+}
+
+// Put appends a value to a key.
+func (m *MultiMap[K, V]) Put(key K, value V) *MultiMap[K, V] {
+	values, exists := m.items[key]
+	if !exists {
+		m.keys = append(m.keys, key)
+	}
+	if m.set && slices.Contains(values, value) {
+		return m
+	}
+	m.items[key] = append(values, value)
+	return m
+}
+
+// PutAll appends several values to a key.
+func (m *MultiMap[K, V]) PutAll(key K, values ...V) *MultiMap[K, V] {
+	for _, v := range values {
+		m.Put(key, v)
+	}
+	return m
+}
+
+// Get returns the values for a key.
+func (m *MultiMap[K, V]) Get(key K) *Collection[V] {
+	return New(slices.Clone(m.items[key]))
+}
+
+// Has determines if all the keys have at least one value.
+func (m *MultiMap[K, V]) Has(keys ...K) bool {
+	for _, key := range keys {
+		if _, ok := m.items[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsEntry determines if the value is stored under the key.
+func (m *MultiMap[K, V]) ContainsEntry(key K, value V) bool {
+	return slices.Contains(m.items[key], value)
+}
+
+// ContainsValue determines if the value is stored under any key.
+func (m *MultiMap[K, V]) ContainsValue(value V) bool {
+	for _, values := range m.items {
+		if slices.Contains(values, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Remove removes the first occurrence of a value under a key and reports whether it was found.
+// The key is removed once it has no values left.
+func (m *MultiMap[K, V]) Remove(key K, value V) bool {
+	values := m.items[key]
+	i := slices.Index(values, value)
+	if i < 0 {
+		return false
+	}
+	values = slices.Delete(values, i, i+1)
+	if len(values) == 0 {
+		m.RemoveAll(key)
+	} else {
+		m.items[key] = values
+	}
+	return true
+}
+
+// RemoveAll removes a key with all of its values and returns them.
+func (m *MultiMap[K, V]) RemoveAll(key K) *Collection[V] {
+	values, ok := m.items[key]
+	if !ok {
+		return Empty[V]()
+	}
+	delete(m.items, key)
+	m.keys = slices.DeleteFunc(m.keys, func(k K) bool { return k == key })
+	return New(values)
+}
+
+// Keys returns all keys in insertion order.
+func (m *MultiMap[K, V]) Keys() *Collection[K] {
+	return New(slices.Clone(m.keys))
+}
+
+// Values returns all values, grouped by key in insertion order.
+func (m *MultiMap[K, V]) Values() *Collection[V] {
+	result := make([]V, 0, m.ValueCount())
+	for _, k := range m.keys {
+		result = append(result, m.items[k]...)
+	}
+	return New(result)
+}
+
+// KeyCount returns the number of distinct keys.
+func (m *MultiMap[K, V]) KeyCount() int {
+	return len(m.keys)
+}
+
+// ValueCount returns the total number of values across all keys.
+func (m *MultiMap[K, V]) ValueCount() int {
+	count := 0
+	for _, values := range m.items {
+		count += len(values)
+	}
+	return count
+}
+
+// CountOf returns the number of values stored under a key.
+func (m *MultiMap[K, V]) CountOf(key K) int {
+	return len(m.items[key])
+}
+
+// IsEmpty determines if the MultiMap is empty.
+func (m *MultiMap[K, V]) IsEmpty() bool {
+	return len(m.keys) == 0
+}
+
+// Each iterates over each key-value entry.
+func (m *MultiMap[K, V]) Each(callback func(K, V)) *MultiMap[K, V] {
+	for _, k := range m.keys {
+		for _, v := range m.items[k] {
+			callback(k, v)
+		}
+	}
+	return m
+}
+
+// Entries returns every key-value entry as a Collection.
+func (m *MultiMap[K, V]) Entries() *Collection[KeyValue[K, V]] {
+	result := make([]KeyValue[K, V], 0, m.ValueCount())
+	m.Each(func(k K, v V) {
+		result = append(result, KeyValue[K, V]{Key: k, Value: v})
+	})
+	return New(result)
+}
+
+// Inverse returns a MultiMap mapping each value to the keys it was stored under.
+// The result uses the same storage kind as the receiver.
+func (m *MultiMap[K, V]) Inverse() *MultiMap[V, K] {
+	result := NewMultiMap[V, K]()
+	result.set = m.set
+	m.Each(func(k K, v V) {
+		result.Put(v, k)
+	})
+	return result
+}
+
+// Clone returns a copy of the MultiMap.
+func (m *MultiMap[K, V]) Clone() *MultiMap[K, V] {
+	result := &MultiMap[K, V]{items: make(map[K][]V, len(m.items)), keys: slices.Clone(m.keys), set: m.set}
+	for k, values := range m.items {
+		result.items[k] = slices.Clone(values)
+	}
+	return result
+}
+
+// ToDictionary converts the MultiMap to a MapCollection of value slices.
+func (m *MultiMap[K, V]) ToDictionary() *MapCollection[K, []V] {
+	result := make(map[K][]V, len(m.items))
+	for k, values := range m.items {
+		result[k] = slices.Clone(values)
+	}
+	return NewMapOrdered(result, m.keys)
+}
+
+// ToJSON converts to JSON.
+func (m *MultiMap[K, V]) ToJSON() ([]byte, error) {
+	return m.ToDictionary().ToJSON()
+}
+
+// String returns a string representation.
+func (m *MultiMap[K, V]) String() string {
+	return m.ToDictionary().String()
+}
@@ -0,0 +1,87 @@
+package collections_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/qiuapeng921/collections"
+)
+
+func TestMultiMapPutGet(t *testing.T) {
+	m := collections.NewMultiMap[string, int]()
+	m.Put("a", 1).Put("a", 2).Put("a", 1).PutAll("b", 3, 4)
+
+	if got := m.Get("a").All(); !slices.Equal(got, []int{1, 2, 1}) {
+		t.Errorf("MultiMap Get failed: %v", got)
+	}
+	if m.KeyCount() != 2 || m.ValueCount() != 5 || m.CountOf("b") != 2 {
+		t.Error("MultiMap counts failed")
+	}
+	if !m.ContainsEntry("b", 4) || m.ContainsEntry("b", 1) || !m.ContainsValue(3) {
+		t.Error("MultiMap ContainsEntry failed")
+	}
+	if m.Get("missing").Count() != 0 {
+		t.Error("MultiMap Get missing failed")
+	}
+}
+
+func TestMultiMapSetValued(t *testing.T) {
+	m := collections.NewSetMultiMap[string, int]()
+	m.Put("a", 1).Put("a", 1).Put("a", 2)
+	if m.CountOf("a") != 2 || !m.IsSetValued() {
+		t.Error("MultiMap set-valued failed")
+	}
+}
+
+func TestMultiMapRemove(t *testing.T) {
+	m := collections.NewMultiMap[string, int]()
+	m.PutAll("a", 1, 2).Put("b", 3)
+
+	if !m.Remove("a", 1) || m.Remove("a", 9) || m.CountOf("a") != 1 {
+		t.Error("MultiMap Remove failed")
+	}
+	m.Remove("b", 3)
+	if m.Has("b") || m.KeyCount() != 1 {
+		t.Error("MultiMap Remove last value should drop key")
+	}
+	removed := m.RemoveAll("a")
+	if removed.Count() != 1 || !m.IsEmpty() {
+		t.Error("MultiMap RemoveAll failed")
+	}
+}
+
+func TestMultiMapInverse(t *testing.T) {
+	m := collections.NewMultiMap[string, string]()
+	m.PutAll("go", "alice", "bob").Put("rust", "alice")
+
+	inv := m.Inverse()
+	if got := inv.Get("alice").All(); !slices.Equal(got, []string{"go", "rust"}) {
+		t.Errorf("MultiMap Inverse failed: %v", got)
+	}
+	if inv.KeyCount() != 2 || inv.ValueCount() != 3 {
+		t.Error("MultiMap Inverse counts failed")
+	}
+}
+
+func TestMapToMultiMap(t *testing.T) {
+	words := collections.New([]string{"apple", "avocado", "banana", "apple"})
+	byLetter := collections.MapToMultiMap(words, func(w string, _ int) (byte, string) { return w[0], w })
+	if byLetter.CountOf('a') != 3 {
+		t.Error("MapToMultiMap failed")
+	}
+	unique := collections.MapToMultiMap(words, func(w string, _ int) (byte, string) { return w[0], w }, true)
+	if unique.CountOf('a') != 2 {
+		t.Error("MapToMultiMap set-valued failed")
+	}
+
+	dict := collections.MapToDictionary(words, func(w string, _ int) (byte, string) { return w[0], w })
+	if collections.MultiMapFromDictionary(dict).ValueCount() != 4 {
+		t.Error("MultiMapFromDictionary failed")
+	}
+	if byLetter.ToDictionary().Get('b')[0] != "banana" {
+		t.Error("MultiMap ToDictionary failed")
+	}
+	if byLetter.Entries().Count() != 4 || byLetter.Values().First() != "apple" {
+		t.Error("MultiMap Entries failed")
+	}
+}
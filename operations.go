@@ -149,6 +149,19 @@ func MapToDictionary[T any, K comparable, V any](c *Collection[T], callback func
 	return NewMapOrdered(result, keys)
 }
 
+// MapToMultiMap maps each item to a key-value pair and collects them into a MultiMap.
+// Pass setValued to drop duplicate values under the same key.
+func MapToMultiMap[T any, K comparable, V comparable](c *Collection[T], callback func(T, int) (K, V), setValued ...bool) *MultiMap[K, V] {
+	result := NewMultiMap[K, V]()
+	if len(setValued) > 0 && setValued[0] {
+		result = NewSetMultiMap[K, V]()
+	}
+	for i, item := range c.items {
+		result.Put(callback(item, i))
+	}
+	return result
+}
+
 // MapToGroups is an alias for MapToDictionary.
 func MapToGroups[T any, K comparable, V any](c *Collection[T], callback func(T, int) (K, V)) *MapCollection[K, []V] {
 	return MapToDictionary(c, callback)
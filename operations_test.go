@@ -399,3 +399,186 @@ func TestThrowUnless(t *testing.T) {
 func TestThrowUnlessTrue(t *testing.T) {
 	collections.ThrowUnless(true, "error")
 }
+
+func TestDataGetLists(t *testing.T) {
+	data := []any{map[string]any{"id": 1}, map[string]any{"id": 2}}
+	if collections.DataGet(data, "1.id") != 2 {
+		t.Error("DataGet list root failed")
+	}
+	ids := collections.DataGet(data, "*.id").([]any)
+	if len(ids) != 2 || ids[0] != 1 {
+		t.Error("DataGet wildcard failed")
+	}
+}
+
+func TestDataSetFillLists(t *testing.T) {
+	data := []any{map[string]any{"id": 1}}
+	data = collections.DataSet(data, "1.id", 2).([]any)
+	if len(data) != 2 || collections.DataGet(data, "1.id") != 2 {
+		t.Error("DataSet grow list failed")
+	}
+	collections.DataFill(data, "*.name", "n/a")
+	collections.DataFill(data, "0.id", 99)
+	if collections.DataGet(data, "0.name") != "n/a" || collections.DataGet(data, "0.id") != 1 {
+		t.Error("DataFill failed")
+	}
+}
+
+type dataAddress struct {
+	City string `json:"city"`
+	Zip  string `json:"zip,omitempty"`
+}
+
+type dataAudit struct {
+	CreatedBy string `json:"created_by"`
+}
+
+type dataUser struct {
+	dataAudit
+	Name    string            `json:"name"`
+	Age     int               `json:"age"`
+	Address *dataAddress      `json:"address"`
+	Labels  map[string]string `json:"labels"`
+	Scores  []int             `json:"scores"`
+	Secret  string            `json:"-"`
+	hidden  string
+}
+
+func TestDataGetStructs(t *testing.T) {
+	u := &dataUser{
+		dataAudit: dataAudit{CreatedBy: "admin"},
+		Name:      "alice",
+		Address:   &dataAddress{City: "Paris"},
+		Labels:    map[string]string{"team": "core"},
+		Scores:    []int{7, 9},
+		Secret:    "s",
+		hidden:    "h",
+	}
+	if collections.DataGet(u, "name") != "alice" || collections.DataGet(*u, "address.city") != "Paris" {
+		t.Error("DataGet struct fields failed")
+	}
+	if collections.DataGet(u, "labels.team") != "core" || collections.DataGet(u, "scores.{last}") != 9 {
+		t.Error("DataGet typed map/slice failed")
+	}
+	if collections.DataGet(u, "created_by") != "admin" || collections.DataGet(u, "Name") != "alice" {
+		t.Error("DataGet embedded/case-insensitive field failed")
+	}
+	if collections.DataGet(u, "Secret", "x") != "x" || collections.DataGet(u, "hidden", "x") != "x" {
+		t.Error("DataGet should skip ignored and unexported fields")
+	}
+
+	var nilAddress *dataAddress
+	if collections.DataGet(map[string]any{"a": nilAddress}, "a.city", "none") != "none" {
+		t.Error("DataGet nil pointer failed")
+	}
+}
+
+func TestDataGetCollections(t *testing.T) {
+	users := collections.New([]dataUser{{Name: "alice"}, {Name: "bob"}})
+	if collections.DataGet(users, "1.name") != "bob" {
+		t.Error("DataGet Collection failed")
+	}
+	ages := collections.NewMap(map[int]int{1: 30})
+	if collections.DataGet(ages, "1") != 30 {
+		t.Error("DataGet MapCollection with int keys failed")
+	}
+	names := collections.DataGet(map[string]any{"users": users}, "users.*.name").([]any)
+	if len(names) != 2 || names[1] != "bob" {
+		t.Error("DataGet wildcard over Collection failed")
+	}
+	byID := map[int]dataUser{2: {Name: "carol"}}
+	if collections.DataGet(byID, "2.name") != "carol" {
+		t.Error("DataGet typed int-keyed map failed")
+	}
+}
+
+func TestDataSetStructs(t *testing.T) {
+	u := &dataUser{}
+	collections.DataSet(u, "name", "alice")
+	collections.DataSet(u, "age", 31.0)
+	collections.DataSet(u, "address.city", "Berlin")
+	collections.DataSet(u, "labels.team", "infra")
+	collections.DataSet(u, "scores.0", 5)
+	if u.Name != "alice" || u.Age != 31 || u.Address == nil || u.Address.City != "Berlin" {
+		t.Errorf("DataSet struct failed: %+v", u)
+	}
+	if u.Labels["team"] != "infra" || len(u.Scores) != 1 || u.Scores[0] != 5 {
+		t.Errorf("DataSet typed containers failed: %+v", u)
+	}
+
+	data := map[string]any{"user": dataUser{Name: "x"}}
+	collections.DataSet(data, "user.name", "y")
+	if data["user"].(dataUser).Name != "y" {
+		t.Error("DataSet struct value inside map failed")
+	}
+
+	users := collections.New([]*dataUser{{Name: "a"}, {Name: "b"}})
+	collections.DataSet(users, "*.age", 20)
+	if users.Get(1).Age != 20 {
+		t.Error("DataSet wildcard over Collection failed")
+	}
+}
+
+func TestDataSetOrFail(t *testing.T) {
+	u := &dataUser{}
+	if _, err := collections.DataSetOrFail(u, "hidden", "x"); err == nil {
+		t.Error("DataSetOrFail should reject unexported field")
+	}
+	if _, err := collections.DataSetOrFail(u, "age", "old"); err == nil {
+		t.Error("DataSetOrFail should reject incompatible type")
+	}
+	if _, err := collections.DataSetOrFail(*u, "name", "x"); err == nil {
+		t.Error("DataSetOrFail should reject non-pointer struct")
+	}
+	if _, err := collections.DataSetOrFail(u, "name.first", "x"); err == nil {
+		t.Error("DataSetOrFail should reject descending into a string")
+	}
+	if _, err := collections.DataSetOrFail("string", "a", 1); err == nil {
+		t.Error("DataSetOrFail should reject scalar target")
+	}
+	if _, err := collections.DataSetOrFail(u, "age", 31.7); err == nil || u.Age != 0 {
+		t.Error("DataSetOrFail should reject a fractional float for an int field")
+	}
+	if _, err := collections.DataSetOrFail(u, "age", 31.0); err != nil || u.Age != 31 {
+		t.Error("DataSetOrFail should accept a whole float for an int field")
+	}
+	if _, err := collections.DataSetOrFail(u, "scores.2000000000", 1); err == nil {
+		t.Error("DataSetOrFail should reject an index past the end of a list")
+	}
+	if _, err := collections.DataSetOrFail(u, "address.zip", "75001"); err != nil || u.Address.Zip != "75001" {
+		t.Error("DataSetOrFail valid path failed")
+	}
+}
+
+func TestDataForgetStructs(t *testing.T) {
+	u := &dataUser{Name: "alice", Labels: map[string]string{"a": "1", "b": "2"}, Scores: []int{1, 2, 3}}
+	collections.DataForget(u, "name", "labels.a", "scores.0")
+	if u.Name != "" || len(u.Labels) != 1 || len(u.Scores) != 2 || u.Scores[0] != 2 {
+		t.Errorf("DataForget struct failed: %+v", u)
+	}
+	m := collections.NewMap(map[string]int{"a": 1, "b": 2})
+	collections.DataForget(m, "a")
+	if m.Has("a") {
+		t.Error("DataForget MapCollection failed")
+	}
+}
+
+func TestDataSetOrFailNumericRange(t *testing.T) {
+	v := &struct {
+		Small int8
+		N     uint
+		F     float32
+	}{}
+	if _, err := collections.DataSetOrFail(v, "Small", 300); err == nil || v.Small != 0 {
+		t.Errorf("DataSetOrFail should reject 300 for int8, stored %d", v.Small)
+	}
+	if _, err := collections.DataSetOrFail(v, "N", -1); err == nil || v.N != 0 {
+		t.Errorf("DataSetOrFail should reject -1 for uint, stored %d", v.N)
+	}
+	if _, err := collections.DataSetOrFail(v, "F", 1e300); err == nil {
+		t.Error("DataSetOrFail should reject 1e300 for float32")
+	}
+	if _, err := collections.DataSetOrFail(v, "Small", int64(-128)); err != nil || v.Small != -128 {
+		t.Errorf("DataSetOrFail int8 in range failed: %d %v", v.Small, err)
+	}
+}
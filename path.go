@@ -0,0 +1,957 @@
+package collections
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Special path segments understood by the dot-notation helpers.
+const (
+	pathWildcard = "*"
+	pathFirst    = "{first}"
+	pathLast     = "{last}"
+)
+
+// writeMode selects what pathWrite does at the end of a path.
+type writeMode int
+
+const (
+	writeSet    writeMode = iota // Always store the value
+	writeFill                    // Store the value only where it is missing
+	writeForget                  // Remove the value
+)
+
+// pathNode is implemented by the generic collection types so paths can descend into
+// any instantiation of them, not only the [string, any] ones.
+type pathNode interface {
+	pathChild(seg string) (any, bool)
+	pathChildren() []any
+	pathKeys() []string
+	pathWriteChild(seg string, rest []string, value any, mode writeMode) error
+}
+
+// splitPath splits a dot-notation path into segments.
+func splitPath(path string) []string {
+	if path == "" {
+		return []string{}
+	}
+	return strings.Split(path, ".")
+}
+
+// parseIndex parses a non-negative list index segment.
+func parseIndex(seg string) (int, bool) {
+	i, err := strconv.Atoi(seg)
+	if err != nil || i < 0 {
+		return 0, false
+	}
+	return i, true
+}
+
+// listIndex parses a list index segment, returning false if it is not a valid index for length n.
+// The "{first}" and "{last}" selectors resolve to the first and last index.
+func listIndex(seg string, n int) (int, bool) {
+	switch seg {
+	case pathFirst:
+		return 0, n > 0
+	case pathLast:
+		return n - 1, n > 0
+	}
+	i, ok := parseIndex(seg)
+	if !ok || i >= n {
+		return 0, false
+	}
+	return i, true
+}
+
+// listSlot is like listIndex but also allows numeric indexes past the end. Callers grow a list
+// only by appending at index n; larger indexes are rejected so a path can never force a huge allocation.
+func listSlot(seg string, n int) (int, bool) {
+	if seg == pathFirst || seg == pathLast {
+		return listIndex(seg, n)
+	}
+	return parseIndex(seg)
+}
+
+// sortedKeys returns the keys of a map in ascending order.
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// indirect follows pointers and interfaces, returning false on nil.
+func indirect(rv reflect.Value) (reflect.Value, bool) {
+	for rv.IsValid() && (rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface) {
+		if rv.IsNil() {
+			return reflect.Value{}, false
+		}
+		rv = rv.Elem()
+	}
+	return rv, rv.IsValid()
+}
+
+// jsonFieldName returns the name a struct field is addressed by, or "" if it is skipped.
+func jsonFieldName(f reflect.StructField) string {
+	tag := f.Tag.Get("json")
+	if tag == "-" {
+		return ""
+	}
+	if name, _, _ := strings.Cut(tag, ","); name != "" {
+		return name
+	}
+	return f.Name
+}
+
+// structField finds an exported field by its json name, falling back to a case-insensitive
+// match like encoding/json. Fields of embedded structs are promoted.
+func structField(rv reflect.Value, name string) (reflect.Value, bool) {
+	var fold reflect.Value
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Tag.Get("json") == "" {
+			if embedded, ok := indirect(rv.Field(i)); ok && embedded.Kind() == reflect.Struct {
+				if field, ok := structField(embedded, name); ok {
+					return field, true
+				}
+			}
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		fieldName := jsonFieldName(f)
+		if fieldName == name {
+			return rv.Field(i), true
+		}
+		if !fold.IsValid() && fieldName != "" && strings.EqualFold(fieldName, name) {
+			fold = rv.Field(i)
+		}
+	}
+	return fold, fold.IsValid()
+}
+
+// structFields returns the exported, non-skipped fields of a struct in declaration order.
+func structFields(rv reflect.Value) []reflect.Value {
+	result := make([]reflect.Value, 0, rv.NumField())
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Tag.Get("json") == "" {
+			if embedded, ok := indirect(rv.Field(i)); ok && embedded.Kind() == reflect.Struct {
+				result = append(result, structFields(embedded)...)
+			}
+			continue
+		}
+		if f.IsExported() && jsonFieldName(f) != "" {
+			result = append(result, rv.Field(i))
+		}
+	}
+	return result
+}
+
+// structFieldNames returns the names of the fields returned by structFields.
+func structFieldNames(rv reflect.Value) []string {
+	result := make([]string, 0, rv.NumField())
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Tag.Get("json") == "" {
+			if embedded, ok := indirect(rv.Field(i)); ok && embedded.Kind() == reflect.Struct {
+				result = append(result, structFieldNames(embedded)...)
+			}
+			continue
+		}
+		if f.IsExported() {
+			if name := jsonFieldName(f); name != "" {
+				result = append(result, name)
+			}
+		}
+	}
+	return result
+}
+
+// mapKey converts a path segment to a reflected map key of type t.
+func mapKey(seg string, t reflect.Type) (reflect.Value, bool) {
+	switch t.Kind() {
+	case reflect.String:
+		return reflect.ValueOf(seg).Convert(t), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(seg, 10, t.Bits())
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		return reflect.ValueOf(n).Convert(t), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(seg, 10, t.Bits())
+		if err != nil {
+			return reflect.Value{}, false
+		}
+		return reflect.ValueOf(n).Convert(t), true
+	case reflect.Interface:
+		if t.NumMethod() == 0 {
+			return reflect.ValueOf(seg), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// sortedMapKeys returns the keys of a reflected map ordered by their string form.
+func sortedMapKeys(rv reflect.Value) []reflect.Value {
+	keys := rv.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+	})
+	return keys
+}
+
+// valueOf returns the interface value of a reflected value, or nil if it is invalid.
+func valueOf(rv reflect.Value) any {
+	if !rv.IsValid() || !rv.CanInterface() {
+		return nil
+	}
+	return rv.Interface()
+}
+
+// pathStep descends one segment into a nested container.
+func pathStep(current any, seg string) (any, bool) {
+	switch v := current.(type) {
+	case map[string]any:
+		if seg == pathFirst || seg == pathLast {
+			return pathEdge(current, seg == pathLast)
+		}
+		val, ok := v[seg]
+		return val, ok
+	case []any:
+		if i, ok := listIndex(seg, len(v)); ok {
+			return v[i], true
+		}
+		return nil, false
+	case pathNode:
+		if reflect.ValueOf(v).IsNil() {
+			return nil, false
+		}
+		return v.pathChild(seg)
+	}
+
+	rv, ok := indirect(reflect.ValueOf(current))
+	if !ok {
+		return nil, false
+	}
+	if seg == pathFirst || seg == pathLast {
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			return pathEdge(current, seg == pathLast)
+		}
+	}
+
+	switch rv.Kind() {
+	case reflect.Struct:
+		if field, ok := structField(rv, seg); ok {
+			return valueOf(field), true
+		}
+	case reflect.Map:
+		if key, ok := mapKey(seg, rv.Type().Key()); ok {
+			if val := rv.MapIndex(key); val.IsValid() {
+				return valueOf(val), true
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if i, ok := listIndex(seg, rv.Len()); ok {
+			return valueOf(rv.Index(i)), true
+		}
+	}
+	return nil, false
+}
+
+// pathEdge returns the first or last child of a container.
+// Plain maps have no order, so their keys are sorted.
+func pathEdge(current any, last bool) (any, bool) {
+	children, ok := pathChildren(current)
+	if !ok || len(children) == 0 {
+		return nil, false
+	}
+	if last {
+		return children[len(children)-1], true
+	}
+	return children[0], true
+}
+
+// pathChildren returns the child values of a container in order.
+func pathChildren(current any) ([]any, bool) {
+	switch v := current.(type) {
+	case map[string]any:
+		result := make([]any, 0, len(v))
+		for _, k := range sortedKeys(v) {
+			result = append(result, v[k])
+		}
+		return result, true
+	case []any:
+		return slices.Clone(v), true
+	case pathNode:
+		if reflect.ValueOf(v).IsNil() {
+			return nil, false
+		}
+		return v.pathChildren(), true
+	}
+
+	rv, ok := indirect(reflect.ValueOf(current))
+	if !ok {
+		return nil, false
+	}
+	var result []any
+	switch rv.Kind() {
+	case reflect.Struct:
+		for _, field := range structFields(rv) {
+			result = append(result, valueOf(field))
+		}
+	case reflect.Map:
+		for _, key := range sortedMapKeys(rv) {
+			result = append(result, valueOf(rv.MapIndex(key)))
+		}
+	case reflect.Slice, reflect.Array:
+		result = make([]any, rv.Len())
+		for i := range result {
+			result[i] = valueOf(rv.Index(i))
+		}
+	default:
+		return nil, false
+	}
+	return result, true
+}
+
+// pathKeys returns the segments addressing each child of a container, in the same order
+// as pathChildren.
+func pathKeys(current any) ([]string, bool) {
+	switch v := current.(type) {
+	case map[string]any:
+		return sortedKeys(v), true
+	case []any:
+		return indexKeys(len(v)), true
+	case pathNode:
+		if reflect.ValueOf(v).IsNil() {
+			return nil, false
+		}
+		return v.pathKeys(), true
+	}
+
+	rv, ok := indirect(reflect.ValueOf(current))
+	if !ok {
+		return nil, false
+	}
+	var result []string
+	switch rv.Kind() {
+	case reflect.Struct:
+		result = structFieldNames(rv)
+	case reflect.Map:
+		for _, key := range sortedMapKeys(rv) {
+			result = append(result, fmt.Sprint(key.Interface()))
+		}
+	case reflect.Slice, reflect.Array:
+		result = indexKeys(rv.Len())
+	default:
+		return nil, false
+	}
+	return result, true
+}
+
+// indexKeys returns the segments "0" to "n-1".
+func indexKeys(n int) []string {
+	result := make([]string, n)
+	for i := range result {
+		result[i] = strconv.Itoa(i)
+	}
+	return result
+}
+
+// pathGet walks the segments from target and returns the value found.
+// A "*" segment fans out over every child and returns a []any of the matches;
+// nested wildcards are flattened into a single list. A wildcard without matches is missing.
+func pathGet(target any, segments []string) (any, bool) {
+	current := target
+	for i, seg := range segments {
+		if seg == pathWildcard {
+			children, ok := pathChildren(current)
+			if !ok {
+				return nil, false
+			}
+			rest := segments[i+1:]
+			nested := slices.Contains(rest, pathWildcard)
+			result := make([]any, 0, len(children))
+			for _, child := range children {
+				v, ok := pathGet(child, rest)
+				if !ok {
+					continue
+				}
+				if list, isList := v.([]any); nested && isList {
+					result = append(result, list...)
+				} else {
+					result = append(result, v)
+				}
+			}
+			return result, len(result) > 0
+		}
+
+		next, ok := pathStep(current, seg)
+		if !ok {
+			return nil, false
+		}
+		current = next
+	}
+	return current, true
+}
+
+// pathWrite applies mode at the segments below current and returns the updated value,
+// which differs from current when a list had to grow, a missing container was created or
+// a struct value was copied. A missing intermediate becomes []any for the segment "0" and
+// map[string]any otherwise, lists only grow by appending at their length, and a "*" segment
+// applies the write below every existing child.
+func pathWrite(current any, exists bool, segments []string, value any, mode writeMode) (any, error) {
+	if current == nil {
+		exists = false
+	}
+	if len(segments) == 0 {
+		if exists && mode == writeFill {
+			return current, nil
+		}
+		return value, nil
+	}
+	seg, rest := segments[0], segments[1:]
+
+	switch v := current.(type) {
+	case map[string]any:
+		if seg == pathWildcard {
+			for _, k := range sortedKeys(v) {
+				if err := pathWriteEntry(v, k, rest, value, mode); err != nil {
+					return v, err
+				}
+			}
+			return v, nil
+		}
+		return v, pathWriteEntry(v, seg, rest, value, mode)
+	case []any:
+		if seg == pathWildcard {
+			if mode == writeForget && len(rest) == 0 {
+				return v[:0], nil
+			}
+			for i := range v {
+				updated, err := pathWrite(v[i], true, rest, value, mode)
+				if err != nil {
+					return v, err
+				}
+				v[i] = updated
+			}
+			return v, nil
+		}
+		i, ok := listSlot(seg, len(v))
+		if !ok {
+			return v, fmt.Errorf("cannot use %q as a list index", seg)
+		}
+		if i >= len(v) {
+			if mode == writeForget {
+				return v, nil
+			}
+			if i > len(v) {
+				return v, fmt.Errorf("index %d out of range for list of length %d", i, len(v))
+			}
+			v = append(v, nil)
+		}
+		if mode == writeForget && len(rest) == 0 {
+			return slices.Delete(v, i, i+1), nil
+		}
+		updated, err := pathWrite(v[i], true, rest, value, mode)
+		v[i] = updated
+		return v, err
+	}
+
+	if current != nil && isReflectContainer(current) {
+		return reflectWrite(current, segments, value, mode)
+	}
+
+	if mode == writeForget || (exists && mode == writeFill) {
+		return current, nil
+	}
+	if seg == pathWildcard {
+		return current, nil
+	}
+	if seg == "0" {
+		child, _ := pathWrite(nil, false, rest, value, mode)
+		return []any{child}, nil
+	}
+	child, _ := pathWrite(nil, false, rest, value, mode)
+	return map[string]any{seg: child}, nil
+}
+
+// pathWriteEntry applies a write to one key of a dynamic map.
+func pathWriteEntry(m map[string]any, key string, rest []string, value any, mode writeMode) error {
+	child, ok := m[key]
+	if mode == writeForget {
+		if !ok {
+			return nil
+		}
+		if len(rest) == 0 {
+			delete(m, key)
+			return nil
+		}
+	}
+	updated, err := pathWrite(child, ok, rest, value, mode)
+	m[key] = updated
+	return err
+}
+
+// isReflectContainer determines if a value is a typed container that reflectWrite can descend into.
+func isReflectContainer(value any) bool {
+	if _, ok := value.(pathNode); ok {
+		return true
+	}
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Pointer, reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+		return true
+	}
+	return false
+}
+
+// reflectWrite applies a write to a typed container and returns the updated value.
+// Struct and array values are copied, so the caller must store the result.
+func reflectWrite(current any, segments []string, value any, mode writeMode) (any, error) {
+	if node, ok := current.(pathNode); ok {
+		if reflect.ValueOf(node).IsNil() {
+			return current, fmt.Errorf("cannot set %q on a nil %T", segments[0], current)
+		}
+		return current, node.pathWriteChild(segments[0], segments[1:], value, mode)
+	}
+
+	rv := reflect.ValueOf(current)
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Map:
+		return current, writeValue(rv, segments, value, mode)
+	}
+	target := reflect.New(rv.Type()).Elem()
+	target.Set(rv)
+	err := writeValue(target, segments, value, mode)
+	return target.Interface(), err
+}
+
+// writeValue applies a write below a reflected value. The value must be settable unless
+// it is a pointer, map or slice, whose contents can be changed in place.
+func writeValue(rv reflect.Value, segments []string, value any, mode writeMode) error {
+	if len(segments) == 0 {
+		if mode == writeFill && !rv.IsZero() {
+			return nil
+		}
+		return assignValue(rv, value)
+	}
+	seg, rest := segments[0], segments[1:]
+
+	if rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		if node, ok := rv.Interface().(pathNode); ok {
+			return node.pathWriteChild(seg, rest, value, mode)
+		}
+	}
+
+	switch rv.Kind() {
+	case reflect.Interface:
+		var inner any
+		if !rv.IsNil() {
+			inner = rv.Elem().Interface()
+		}
+		updated, err := pathWrite(inner, !rv.IsNil(), segments, value, mode)
+		if err != nil {
+			return err
+		}
+		if updated != nil && rv.CanSet() {
+			rv.Set(reflect.ValueOf(updated))
+		}
+		return nil
+
+	case reflect.Pointer:
+		if rv.IsNil() {
+			if mode == writeForget {
+				return nil
+			}
+			if !rv.CanSet() {
+				return fmt.Errorf("cannot set %q through a nil %s", seg, rv.Type())
+			}
+			rv.Set(reflect.New(rv.Type().Elem()))
+		}
+		return writeValue(rv.Elem(), segments, value, mode)
+
+	case reflect.Struct:
+		fields := []reflect.Value{}
+		if seg == pathWildcard {
+			fields = structFields(rv)
+		} else if field, ok := structField(rv, seg); ok {
+			fields = append(fields, field)
+		} else {
+			return fmt.Errorf("%s has no field %q", rv.Type(), seg)
+		}
+		for _, field := range fields {
+			if !field.CanSet() {
+				return fmt.Errorf("cannot set field %q of %s: value is not addressable", seg, rv.Type())
+			}
+			if mode == writeForget && len(rest) == 0 {
+				field.Set(reflect.Zero(field.Type()))
+				continue
+			}
+			if err := writeValue(field, rest, value, mode); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case reflect.Map:
+		keys := []reflect.Value{}
+		if seg == pathWildcard {
+			keys = sortedMapKeys(rv)
+		} else if key, ok := mapKey(seg, rv.Type().Key()); ok {
+			keys = append(keys, key)
+		} else {
+			return fmt.Errorf("cannot use %q as a %s key", seg, rv.Type().Key())
+		}
+		if rv.IsNil() {
+			if mode == writeForget {
+				return nil
+			}
+			if !rv.CanSet() {
+				return fmt.Errorf("cannot set %q in a nil %s", seg, rv.Type())
+			}
+			rv.Set(reflect.MakeMap(rv.Type()))
+		}
+		for _, key := range keys {
+			existing := rv.MapIndex(key)
+			if mode == writeForget && len(rest) == 0 {
+				rv.SetMapIndex(key, reflect.Value{})
+				continue
+			}
+			if mode == writeForget && !existing.IsValid() {
+				continue
+			}
+			elem := reflect.New(rv.Type().Elem()).Elem()
+			if existing.IsValid() {
+				elem.Set(existing)
+			}
+			if err := writeValue(elem, rest, value, mode); err != nil {
+				return err
+			}
+			rv.SetMapIndex(key, elem)
+		}
+		return nil
+
+	case reflect.Slice, reflect.Array:
+		if seg == pathWildcard {
+			if mode == writeForget && len(rest) == 0 && rv.Kind() == reflect.Slice && rv.CanSet() {
+				rv.Set(rv.Slice(0, 0))
+				return nil
+			}
+			for i := 0; i < rv.Len(); i++ {
+				if err := writeValue(rv.Index(i), rest, value, mode); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		i, ok := listSlot(seg, rv.Len())
+		if !ok {
+			return fmt.Errorf("cannot use %q as a list index", seg)
+		}
+		if i >= rv.Len() {
+			if mode == writeForget {
+				return nil
+			}
+			if i > rv.Len() || rv.Kind() == reflect.Array || !rv.CanSet() {
+				return fmt.Errorf("index %d out of range for %s of length %d", i, rv.Type(), rv.Len())
+			}
+			rv.Set(reflect.Append(rv, reflect.Zero(rv.Type().Elem())))
+		}
+		if mode == writeForget && len(rest) == 0 {
+			if rv.Kind() == reflect.Slice && rv.CanSet() {
+				rv.Set(reflect.AppendSlice(rv.Slice(0, i), rv.Slice(i+1, rv.Len())))
+				return nil
+			}
+			rv.Index(i).Set(reflect.Zero(rv.Type().Elem()))
+			return nil
+		}
+		return writeValue(rv.Index(i), rest, value, mode)
+	}
+
+	if mode == writeForget {
+		return nil
+	}
+	return fmt.Errorf("cannot set %q below a %s", seg, rv.Type())
+}
+
+// assignValue stores value in a settable reflected value, converting between numeric kinds
+// when no precision is lost.
+func assignValue(rv reflect.Value, value any) error {
+	if !rv.CanSet() {
+		return fmt.Errorf("cannot set %s: value is not addressable", rv.Type())
+	}
+	if value == nil {
+		rv.Set(reflect.Zero(rv.Type()))
+		return nil
+	}
+	v := reflect.ValueOf(value)
+	if v.Type().AssignableTo(rv.Type()) {
+		rv.Set(v)
+		return nil
+	}
+	if isNumericKind(v.Kind()) && isNumericKind(rv.Kind()) {
+		if err := assignNumber(rv, value); err != nil {
+			return fmt.Errorf("cannot assign %v to %s: %w", value, rv.Type(), err)
+		}
+		return nil
+	}
+	if v.Kind() == reflect.String && rv.Kind() == reflect.String {
+		rv.Set(v.Convert(rv.Type()))
+		return nil
+	}
+	return fmt.Errorf("cannot assign %T to %s", value, rv.Type())
+}
+
+// assignNumber stores a number in a numeric reflected value, failing instead of truncating
+// fractions or wrapping values that are out of range for its kind.
+func assignNumber(rv reflect.Value, value any) error {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := coerceInt(value)
+		if err != nil {
+			return err
+		}
+		if rv.OverflowInt(n) {
+			return fmt.Errorf("%d is out of range", n)
+		}
+		rv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := coerceUint(value)
+		if err != nil {
+			return err
+		}
+		if rv.OverflowUint(n) {
+			return fmt.Errorf("%d is out of range", n)
+		}
+		rv.SetUint(n)
+	default:
+		f, err := coerceNumber(value)
+		if err != nil {
+			return err
+		}
+		if rv.OverflowFloat(f) {
+			return fmt.Errorf("%v is out of range", f)
+		}
+		rv.SetFloat(f)
+	}
+	return nil
+}
+
+// isNumericKind determines if a kind is an integer or floating-point kind.
+func isNumericKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// pathSet sets value at the segments below target, creating intermediate containers as needed.
+func pathSet(target any, segments []string, value any) error {
+	if len(segments) == 0 {
+		return nil
+	}
+	_, err := pathWrite(target, true, segments, value, writeSet)
+	return err
+}
+
+// pathForget removes the value at the segments below target.
+func pathForget(target any, segments []string) error {
+	if len(segments) == 0 {
+		return nil
+	}
+	_, err := pathWrite(target, true, segments, nil, writeForget)
+	return err
+}
+
+// isPathContainer determines if a value can be descended into by the path helpers.
+func isPathContainer(value any) bool {
+	switch value.(type) {
+	case nil:
+		return false
+	case map[string]any, []any:
+		return true
+	}
+	return isReflectContainer(value)
+}
+
+// pathChild implements pathNode.
+func (c *Collection[T]) pathChild(seg string) (any, bool) {
+	if i, ok := listIndex(seg, len(c.items)); ok {
+		return c.items[i], true
+	}
+	return nil, false
+}
+
+// pathChildren implements pathNode.
+func (c *Collection[T]) pathChildren() []any {
+	result := make([]any, len(c.items))
+	for i, item := range c.items {
+		result[i] = item
+	}
+	return result
+}
+
+// pathKeys implements pathNode.
+func (c *Collection[T]) pathKeys() []string {
+	return indexKeys(len(c.items))
+}
+
+// pathWriteChild implements pathNode.
+func (c *Collection[T]) pathWriteChild(seg string, rest []string, value any, mode writeMode) error {
+	indexes := []int{}
+	if seg == pathWildcard {
+		for i := range c.items {
+			indexes = append(indexes, i)
+		}
+	} else if i, ok := listSlot(seg, len(c.items)); ok {
+		indexes = append(indexes, i)
+	} else {
+		return fmt.Errorf("cannot use %q as a collection index", seg)
+	}
+
+	if mode == writeForget && len(rest) == 0 {
+		c.Forget(indexes...)
+		return nil
+	}
+	for _, i := range indexes {
+		if i >= len(c.items) {
+			if mode == writeForget {
+				continue
+			}
+			if i > len(c.items) {
+				return fmt.Errorf("index %d out of range for collection of length %d", i, len(c.items))
+			}
+			var zero T
+			c.items = append(c.items, zero)
+		}
+		updated, err := pathWrite(any(c.items[i]), true, rest, value, mode)
+		if err != nil {
+			return err
+		}
+		item, err := convertTo[T](updated)
+		if err != nil {
+			return err
+		}
+		c.items[i] = item
+	}
+	return nil
+}
+
+// pathChild implements pathNode.
+func (m *MapCollection[K, V]) pathChild(seg string) (any, bool) {
+	if seg == pathFirst || seg == pathLast {
+		if m.IsEmpty() {
+			return nil, false
+		}
+		if seg == pathLast {
+			return m.Last(), true
+		}
+		return m.First(), true
+	}
+	key, ok := convertKey[K](seg)
+	if !ok {
+		return nil, false
+	}
+	val, ok := m.items[key]
+	return val, ok
+}
+
+// pathChildren implements pathNode.
+func (m *MapCollection[K, V]) pathChildren() []any {
+	result := make([]any, len(m.keys))
+	for i, k := range m.keys {
+		result[i] = m.items[k]
+	}
+	return result
+}
+
+// pathKeys implements pathNode.
+func (m *MapCollection[K, V]) pathKeys() []string {
+	result := make([]string, len(m.keys))
+	for i, k := range m.keys {
+		result[i] = fmt.Sprint(k)
+	}
+	return result
+}
+
+// pathWriteChild implements pathNode.
+func (m *MapCollection[K, V]) pathWriteChild(seg string, rest []string, value any, mode writeMode) error {
+	keys := []K{}
+	switch {
+	case seg == pathWildcard:
+		keys = slices.Clone(m.keys)
+	case seg == pathFirst && m.IsNotEmpty():
+		keys = append(keys, m.FirstKey())
+	case seg == pathLast && m.IsNotEmpty():
+		keys = append(keys, m.LastKey())
+	default:
+		key, ok := convertKey[K](seg)
+		if !ok {
+			var zero K
+			return fmt.Errorf("cannot use %q as a %T key", seg, zero)
+		}
+		keys = append(keys, key)
+	}
+
+	for _, key := range keys {
+		existing, exists := m.items[key]
+		if mode == writeForget {
+			if !exists {
+				continue
+			}
+			if len(rest) == 0 {
+				m.Forget(key)
+				continue
+			}
+		}
+		updated, err := pathWrite(any(existing), exists, rest, value, mode)
+		if err != nil {
+			return err
+		}
+		item, err := convertTo[V](updated)
+		if err != nil {
+			return err
+		}
+		m.Put(key, item)
+	}
+	return nil
+}
+
+// convertTo converts a value to T, applying the same conversions as assignValue.
+func convertTo[T any](value any) (T, error) {
+	var result T
+	if v, ok := value.(T); ok || value == nil {
+		return v, nil
+	}
+	err := assignValue(reflect.ValueOf(&result).Elem(), value)
+	return result, err
+}
+
+// convertKey converts a path segment to a map key type.
+func convertKey[K comparable](seg string) (K, bool) {
+	var zero K
+	if k, ok := any(seg).(K); ok {
+		return k, true
+	}
+	rv, ok := mapKey(seg, reflect.TypeOf(&zero).Elem())
+	if !ok {
+		return zero, false
+	}
+	k, ok := rv.Interface().(K)
+	return k, ok
+}
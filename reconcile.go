@@ -0,0 +1,72 @@
+package collections
+
+import "reflect"
+
+// Reconciliation is the result of comparing an old and a new collection by key.
+type Reconciliation[T any] struct {
+	Created   *Collection[T]
+	Updated   *Collection[Change[T]]
+	Deleted   *Collection[T]
+	Unchanged *Collection[T]
+}
+
+// HasChanges determines if anything was created, updated or deleted.
+func (r *Reconciliation[T]) HasChanges() bool {
+	return r.Created.IsNotEmpty() || r.Updated.IsNotEmpty() || r.Deleted.IsNotEmpty()
+}
+
+// Reconcile matches items of oldItems and newItems by key and sorts them into created, updated,
+// deleted and unchanged. Created, updated and unchanged follow the order of newItems;
+// deleted follows the order of oldItems. If several items share a key, the last one wins.
+// Items are compared with equalFn if given, or reflect.DeepEqual otherwise.
+func Reconcile[T any, K comparable](oldItems, newItems *Collection[T], keyFn func(T) K, equalFn func(a, b T) bool) *Reconciliation[T] {
+	if equalFn == nil {
+		equalFn = func(a, b T) bool {
+			return reflect.DeepEqual(a, b)
+		}
+	}
+	before := KeyBy(oldItems, keyFn)
+	after := KeyBy(newItems, keyFn)
+
+	result := &Reconciliation[T]{
+		Created:   Empty[T](),
+		Updated:   Empty[Change[T]](),
+		Deleted:   before.DiffKeys(after).Values(),
+		Unchanged: Empty[T](),
+	}
+	after.Each(func(k K, item T) {
+		previous, exists := before.items[k]
+		switch {
+		case !exists:
+			result.Created.Push(item)
+		case equalFn(previous, item):
+			result.Unchanged.Push(item)
+		default:
+			result.Updated.Push(Change[T]{Old: previous, New: item})
+		}
+	})
+	return result
+}
+
+// UpsertBy updates items in the collection that match an incoming item by key and appends
+// the rest. mergeFn combines the existing and incoming item; if nil, the incoming item replaces it.
+func UpsertBy[T any, K comparable](c *Collection[T], items []T, keyFn func(T) K, mergeFn func(existing, incoming T) T) *Collection[T] {
+	index := make(map[K]int, len(c.items))
+	for i, item := range c.items {
+		index[keyFn(item)] = i
+	}
+
+	for _, incoming := range items {
+		k := keyFn(incoming)
+		if i, exists := index[k]; exists {
+			if mergeFn != nil {
+				incoming = mergeFn(c.items[i], incoming)
+			}
+			c.items[i] = incoming
+			continue
+		}
+		index[k] = len(c.items)
+		c.items = append(c.items, incoming)
+	}
+	return c
+}
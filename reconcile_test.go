@@ -0,0 +1,70 @@
+package collections_test
+
+import (
+	"testing"
+
+	"github.com/qiuapeng921/collections"
+)
+
+type syncItem struct {
+	ID   int
+	Name string
+	Qty  int
+}
+
+func syncItemKey(i syncItem) int { return i.ID }
+
+func TestReconcile(t *testing.T) {
+	local := collections.New([]syncItem{{1, "a", 1}, {2, "b", 2}, {3, "c", 3}})
+	remote := collections.New([]syncItem{{2, "b", 5}, {3, "c", 3}, {4, "d", 4}})
+
+	r := collections.Reconcile(local, remote, syncItemKey, func(a, b syncItem) bool { return a == b })
+	if r.Created.Count() != 1 || r.Created.First().ID != 4 {
+		t.Error("Reconcile created failed")
+	}
+	if r.Deleted.Count() != 1 || r.Deleted.First().ID != 1 {
+		t.Error("Reconcile deleted failed")
+	}
+	if r.Unchanged.Count() != 1 || r.Unchanged.First().ID != 3 {
+		t.Error("Reconcile unchanged failed")
+	}
+	update := r.Updated.First()
+	if r.Updated.Count() != 1 || update.Old.Qty != 2 || update.New.Qty != 5 {
+		t.Error("Reconcile updated failed")
+	}
+	if !r.HasChanges() {
+		t.Error("Reconcile HasChanges failed")
+	}
+
+	same := collections.Reconcile(local, local.Clone(), syncItemKey, func(a, b syncItem) bool { return a == b })
+	if same.HasChanges() || same.Unchanged.Count() != 3 {
+		t.Error("Reconcile identical collections failed")
+	}
+}
+
+func TestReconcileDefaultEqual(t *testing.T) {
+	local := collections.New([]syncItem{{1, "a", 1}, {2, "b", 2}})
+	remote := collections.New([]syncItem{{1, "a", 1}, {2, "b", 3}})
+
+	r := collections.Reconcile(local, remote, syncItemKey, nil)
+	if r.Unchanged.Count() != 1 || r.Updated.Count() != 1 || r.Updated.First().New.Qty != 3 {
+		t.Error("Reconcile with nil equalFn should use reflect.DeepEqual")
+	}
+}
+
+func TestUpsertBy(t *testing.T) {
+	c := collections.New([]syncItem{{1, "a", 1}, {2, "b", 2}})
+	collections.UpsertBy(c, []syncItem{{2, "", 10}, {3, "c", 3}}, syncItemKey, func(existing, incoming syncItem) syncItem {
+		existing.Qty += incoming.Qty
+		return existing
+	})
+
+	if c.Count() != 3 || c.Get(1).Qty != 12 || c.Get(1).Name != "b" || c.Get(2).ID != 3 {
+		t.Errorf("UpsertBy merge failed: %v", c.All())
+	}
+
+	collections.UpsertBy(c, []syncItem{{1, "z", 0}, {5, "e", 5}, {5, "f", 6}}, syncItemKey, nil)
+	if c.Get(0).Name != "z" || c.Count() != 4 || c.Last().Name != "f" {
+		t.Errorf("UpsertBy replace failed: %v", c.All())
+	}
+}
@@ -0,0 +1,396 @@
+package collections
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Masker replaces a sensitive value with a masked one.
+type Masker func(value any) any
+
+// FullMasker returns a Masker replacing values with the replacement, or "[REDACTED]" if it is empty.
+// Nil values are kept.
+func FullMasker(replacement string) Masker {
+	if replacement == "" {
+		replacement = "[REDACTED]"
+	}
+	return func(value any) any {
+		if value == nil {
+			return nil
+		}
+		return replacement
+	}
+}
+
+// PartialMasker returns a Masker that keeps the last visible characters of a value and
+// replaces the rest with "*", so a card number becomes "************1234". Values no longer
+// than visible are masked entirely, and a negative visible counts as zero. Nil values are kept.
+func PartialMasker(visible int) Masker {
+	visible = max(visible, 0)
+	return func(value any) any {
+		if value == nil {
+			return nil
+		}
+		s := queryString(value)
+		n := utf8.RuneCountInString(s)
+		if n <= visible {
+			return strings.Repeat("*", n)
+		}
+		runes := []rune(s)
+		return strings.Repeat("*", n-visible) + string(runes[n-visible:])
+	}
+}
+
+// HashMasker returns a Masker replacing values with "sha256:" and the hex SHA-256 of the salt
+// followed by the value, so equal values can still be correlated. Nil values are kept.
+func HashMasker(salt string) Masker {
+	return func(value any) any {
+		if value == nil {
+			return nil
+		}
+		sum := sha256.Sum256([]byte(salt + queryString(value)))
+		return "sha256:" + hex.EncodeToString(sum[:])
+	}
+}
+
+// Redactor masks sensitive values in nested data.
+//
+// Patterns are either dot paths or key-name regexes. In a dot path, "*" matches one segment
+// (a map key or list index) and "**" matches any number of segments, so "*.password" matches
+// "user.password" and "**.token" matches a token at any depth. A pattern wrapped in slashes,
+// such as "/(?i)token$/", matches the key of a value at any depth.
+type Redactor struct {
+	rules  []redactRule
+	masker Masker
+}
+
+// redactRule is one pattern of a Redactor and the masker it uses, nil for the default.
+type redactRule struct {
+	segments []string
+	regex    *regexp.Regexp
+	masker   Masker
+}
+
+// NewRedactor creates a Redactor for the patterns using FullMasker by default.
+// It panics if a regex pattern does not compile.
+func NewRedactor(patterns ...string) *Redactor {
+	r := &Redactor{masker: FullMasker("")}
+	return r.Add(nil, patterns...)
+}
+
+// WithMasker sets the masker used by patterns added without one.
+func (r *Redactor) WithMasker(masker Masker) *Redactor {
+	r.masker = masker
+	return r
+}
+
+// Add adds patterns masked with the given masker; nil uses the default masker.
+// It panics if a regex pattern does not compile.
+func (r *Redactor) Add(masker Masker, patterns ...string) *Redactor {
+	for _, pattern := range patterns {
+		rule := redactRule{masker: masker}
+		if len(pattern) >= 2 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+			rule.regex = regexp.MustCompile(pattern[1 : len(pattern)-1])
+		} else {
+			rule.segments = splitPath(pattern)
+		}
+		r.rules = append(r.rules, rule)
+	}
+	return r
+}
+
+// Redact returns a deep copy of the data with matching values masked. Maps, slices, arrays,
+// structs, pointers and collections are copied with their type, and a masked value that does
+// not fit its field or item type becomes the zero value. Struct fields are addressed by their
+// json name like in DataGet. Unexported struct fields, byte slices and other values are kept
+// as-is, so a secret in them is only masked when a pattern matches the value itself.
+func (r *Redactor) Redact(data any) any {
+	return r.redact(data, nil)
+}
+
+// RedactMap is Redact for a map[string]any.
+func (r *Redactor) RedactMap(data map[string]any) map[string]any {
+	return r.redact(data, nil).(map[string]any)
+}
+
+// LogValuer wraps data so that slog logs a redacted copy of it.
+func (r *Redactor) LogValuer(data any) slog.LogValuer {
+	return redactedValue{r, data}
+}
+
+// ReplaceAttr redacts slog attributes and can be used as slog.HandlerOptions.ReplaceAttr.
+// The path of an attribute is its groups followed by its key.
+func (r *Redactor) ReplaceAttr(groups []string, a slog.Attr) slog.Attr {
+	path := append(slices.Clone(groups), a.Key)
+	if masker, ok := r.match(path); ok {
+		return slog.Any(a.Key, masker(a.Value.Any()))
+	}
+	if a.Value.Kind() == slog.KindAny && isPathContainer(a.Value.Any()) {
+		return slog.Any(a.Key, plainValue(r.redact(a.Value.Any(), path)))
+	}
+	return a
+}
+
+// match returns the masker of the first rule matching the path.
+func (r *Redactor) match(path []string) (Masker, bool) {
+	if len(path) == 0 {
+		return nil, false
+	}
+	for _, rule := range r.rules {
+		var matched bool
+		if rule.regex != nil {
+			matched = rule.regex.MatchString(path[len(path)-1])
+		} else {
+			matched = matchSegments(rule.segments, path)
+		}
+		if matched {
+			if rule.masker != nil {
+				return rule.masker, true
+			}
+			return r.masker, true
+		}
+	}
+	return nil, false
+}
+
+// redact copies a value below path, masking matching children.
+func (r *Redactor) redact(value any, path []string) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for k, child := range v {
+			result[k] = r.redactChild(child, path, k)
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, child := range v {
+			result[i] = r.redactChild(child, path, strconv.Itoa(i))
+		}
+		return result
+	case redactable:
+		return v.redactWith(r, path)
+	case nil:
+		return nil
+	}
+	return r.redactReflect(reflect.ValueOf(value), path)
+}
+
+// redactReflect copies a typed container below path, masking matching children.
+func (r *Redactor) redactReflect(rv reflect.Value, path []string) any {
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return rv.Interface()
+		}
+		result := reflect.New(rv.Type().Elem())
+		if err := assignValue(result.Elem(), r.redact(valueOf(rv.Elem()), path)); err != nil {
+			return reflect.Zero(rv.Type()).Interface()
+		}
+		return result.Interface()
+	case reflect.Map:
+		if rv.IsNil() {
+			return rv.Interface()
+		}
+		result := reflect.MakeMapWithSize(rv.Type(), rv.Len())
+		for _, key := range sortedMapKeys(rv) {
+			elem := reflect.New(rv.Type().Elem()).Elem()
+			r.assignChild(elem, valueOf(rv.MapIndex(key)), path, fmt.Sprint(key.Interface()))
+			result.SetMapIndex(key, elem)
+		}
+		return result.Interface()
+	case reflect.Slice:
+		if rv.IsNil() || rv.Type().Elem().Kind() == reflect.Uint8 {
+			return rv.Interface()
+		}
+		result := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		r.redactInto(result, rv, path)
+		return result.Interface()
+	case reflect.Array, reflect.Struct:
+		result := reflect.New(rv.Type()).Elem()
+		result.Set(rv)
+		r.redactInto(result, rv, path)
+		return result.Interface()
+	}
+	return rv.Interface()
+}
+
+// redactInto stores the redacted children of a slice, array or struct in a settable copy of it.
+// Embedded struct pointers are copied too, so the copy never shares memory with the source.
+func (r *Redactor) redactInto(dst, src reflect.Value, path []string) {
+	switch src.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < src.Len(); i++ {
+			r.assignChild(dst.Index(i), valueOf(src.Index(i)), path, strconv.Itoa(i))
+		}
+	case reflect.Struct:
+		t := src.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.Anonymous && f.Tag.Get("json") == "" {
+				field := src.Field(i)
+				if field.Kind() == reflect.Pointer && !field.IsNil() && field.Elem().Kind() == reflect.Struct && dst.Field(i).CanSet() {
+					embedded := reflect.New(field.Type().Elem())
+					embedded.Elem().Set(field.Elem())
+					r.redactInto(embedded.Elem(), field.Elem(), path)
+					dst.Field(i).Set(embedded)
+				} else if field.Kind() == reflect.Struct {
+					r.redactInto(dst.Field(i), field, path)
+				}
+				continue
+			}
+			if name := jsonFieldName(f); f.IsExported() && name != "" {
+				r.assignChild(dst.Field(i), valueOf(src.Field(i)), path, name)
+			}
+		}
+	}
+}
+
+// assignChild stores the redacted child in dst, leaving the zero value if it does not fit.
+func (r *Redactor) assignChild(dst reflect.Value, child any, path []string, key string) {
+	if err := assignValue(dst, r.redactChild(child, path, key)); err != nil {
+		dst.Set(reflect.Zero(dst.Type()))
+	}
+}
+
+// redactChild masks a child if its path matches, or redacts inside it otherwise.
+func (r *Redactor) redactChild(child any, path []string, key string) any {
+	childPath := append(slices.Clip(path), key)
+	if masker, ok := r.match(childPath); ok {
+		return masker(child)
+	}
+	return r.redact(child, childPath)
+}
+
+// matchSegments matches a path against pattern segments with "*" and "**" wildcards.
+func matchSegments(pattern, path []string) bool {
+	if len(pattern) == 0 {
+		return len(path) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(path); i++ {
+			if matchSegments(pattern[1:], path[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(path) == 0 || (pattern[0] != pathWildcard && pattern[0] != path[0]) {
+		return false
+	}
+	return matchSegments(pattern[1:], path[1:])
+}
+
+// redactedValue implements slog.LogValuer for Redactor.LogValuer.
+type redactedValue struct {
+	redactor *Redactor
+	data     any
+}
+
+// LogValue implements slog.LogValuer.
+func (v redactedValue) LogValue() slog.Value {
+	return slog.AnyValue(plainValue(v.redactor.Redact(v.data)))
+}
+
+// redactable is implemented by the collection types so Redact can copy them with their type.
+type redactable interface {
+	redactWith(r *Redactor, path []string) any
+	plainValue() any
+}
+
+// plainValue converts collections nested in a value into maps and lists, for logging.
+func plainValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(v))
+		for k, child := range v {
+			result[k] = plainValue(child)
+		}
+		return result
+	case []any:
+		result := make([]any, len(v))
+		for i, child := range v {
+			result[i] = plainValue(child)
+		}
+		return result
+	case redactable:
+		return v.plainValue()
+	}
+	return value
+}
+
+func (c *Collection[T]) redactWith(r *Redactor, path []string) any {
+	if c == nil {
+		return c
+	}
+	result := make([]T, len(c.items))
+	for i, item := range c.items {
+		if redacted, err := convertTo[T](r.redactChild(item, path, strconv.Itoa(i))); err == nil {
+			result[i] = redacted
+		}
+	}
+	return New(result)
+}
+
+func (c *Collection[T]) plainValue() any {
+	if c == nil {
+		return nil
+	}
+	result := make([]any, len(c.items))
+	for i, item := range c.items {
+		result[i] = plainValue(item)
+	}
+	return result
+}
+
+func (m *MapCollection[K, V]) redactWith(r *Redactor, path []string) any {
+	if m == nil {
+		return m
+	}
+	result := NewMap[K, V](nil)
+	for _, k := range m.keys {
+		var item V
+		if redacted, err := convertTo[V](r.redactChild(m.items[k], path, fmt.Sprint(k))); err == nil {
+			item = redacted
+		}
+		result.Put(k, item)
+	}
+	return result
+}
+
+func (m *MapCollection[K, V]) plainValue() any {
+	if m == nil {
+		return nil
+	}
+	result := make(map[string]any, len(m.keys))
+	for _, k := range m.keys {
+		result[fmt.Sprint(k)] = plainValue(m.items[k])
+	}
+	return result
+}
+
+// Redact returns a deep copy of the map with values matching the patterns replaced by
+// "[REDACTED]". See Redactor for the pattern syntax and for other maskers.
+func (ArrHelpers) Redact(data map[string]any, patterns ...string) map[string]any {
+	return NewRedactor(patterns...).RedactMap(data)
+}
+
+// RedactedDump prints the collection with sensitive values masked by the redactor.
+func (c *Collection[T]) RedactedDump(r *Redactor) *Collection[T] {
+	fmt.Printf("%+v\n", r.Redact(c).(*Collection[T]).items)
+	return c
+}
+
+// RedactedDump prints the map with sensitive values masked by the redactor.
+func (m *MapCollection[K, V]) RedactedDump(r *Redactor) *MapCollection[K, V] {
+	fmt.Printf("%+v\n", r.Redact(m).(*MapCollection[K, V]).items)
+	return m
+}
@@ -0,0 +1,203 @@
+package collections_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/qiuapeng921/collections"
+)
+
+func TestArrRedact(t *testing.T) {
+	data := map[string]any{
+		"user":     map[string]any{"name": "Jane", "password": "hunter2"},
+		"password": "top-level",
+	}
+	redacted := collections.Arr.Redact(data, "*.password")
+
+	expected := map[string]any{
+		"user":     map[string]any{"name": "Jane", "password": "[REDACTED]"},
+		"password": "top-level",
+	}
+	if !reflect.DeepEqual(redacted, expected) {
+		t.Errorf("Redact failed: %v", redacted)
+	}
+	if data["user"].(map[string]any)["password"] != "hunter2" {
+		t.Error("Redact mutated input")
+	}
+}
+
+func TestArrRedactListWildcard(t *testing.T) {
+	data := map[string]any{"cards": []any{map[string]any{"number": "4111111111111234", "holder": "Jane"}}}
+	redacted := collections.Arr.Redact(data, "cards.*.number")
+	if collections.Arr.Get(redacted, "cards.0.number") != "[REDACTED]" || collections.Arr.Get(redacted, "cards.0.holder") != "Jane" {
+		t.Errorf("Redact list wildcard failed: %v", redacted)
+	}
+}
+
+func TestArrRedactKeyRegex(t *testing.T) {
+	data := map[string]any{"access_token": "abc", "session": map[string]any{"refreshToken": "def", "id": 7}}
+	redacted := collections.Arr.Redact(data, "/(?i)token$/")
+	if redacted["access_token"] != "[REDACTED]" || collections.Arr.Get(redacted, "session.refreshToken") != "[REDACTED]" {
+		t.Errorf("Redact key regex failed: %v", redacted)
+	}
+	if collections.Arr.Get(redacted, "session.id") != 7 {
+		t.Error("Redact key regex masked too much")
+	}
+}
+
+func TestArrRedactAnyDepth(t *testing.T) {
+	data := map[string]any{"api": map[string]any{"auth": map[string]any{"secret": "s"}}}
+	redacted := collections.Arr.Redact(data, "**.secret")
+	if collections.Arr.Get(redacted, "api.auth.secret") != "[REDACTED]" {
+		t.Errorf("Redact ** failed: %v", redacted)
+	}
+}
+
+func TestMaskers(t *testing.T) {
+	partial := collections.PartialMasker(4)
+	if partial("4111111111111234") != "************1234" || partial("123") != "***" || partial(12345) != "*2345" {
+		t.Error("PartialMasker failed")
+	}
+	if collections.PartialMasker(-1)("1234") != "****" {
+		t.Error("PartialMasker with negative visible failed")
+	}
+	hash := collections.HashMasker("salt")
+	if hash("a") != hash("a") || hash("a") == hash("b") || !strings.HasPrefix(hash("a").(string), "sha256:") {
+		t.Error("HashMasker failed")
+	}
+	if collections.FullMasker("***")("x") != "***" || collections.FullMasker("")(nil) != nil {
+		t.Error("FullMasker failed")
+	}
+}
+
+func TestRedactorMaskers(t *testing.T) {
+	r := collections.NewRedactor("user.password").
+		WithMasker(collections.FullMasker("<hidden>")).
+		Add(collections.PartialMasker(4), "cards.*.number")
+	redacted := r.RedactMap(map[string]any{
+		"user":  map[string]any{"password": "hunter2"},
+		"cards": []any{map[string]any{"number": "4111111111111234"}},
+	})
+	if collections.Arr.Get(redacted, "user.password") != "<hidden>" {
+		t.Error("Default masker failed")
+	}
+	if collections.Arr.Get(redacted, "cards.0.number") != "************1234" {
+		t.Error("Per-pattern masker failed")
+	}
+}
+
+func TestRedactCollections(t *testing.T) {
+	r := collections.NewRedactor("*.password", "*.nested.token")
+	users := collections.New([]map[string]any{
+		{"name": "a", "password": "x"},
+		{"name": "b", "password": "y"},
+	})
+	redacted := r.Redact(users).(*collections.Collection[map[string]any])
+	if redacted.First()["password"] != "[REDACTED]" || users.First()["password"] != "x" {
+		t.Error("Redact Collection failed")
+	}
+
+	m := collections.NewMap(map[string]any{
+		"config": collections.NewMap(map[string]any{"password": "p", "nested": map[string]any{"token": "t"}}),
+	})
+	redactedMap := r.Redact(m).(*collections.MapCollection[string, any])
+	config := redactedMap.Get("config").(*collections.MapCollection[string, any])
+	if config.Get("password") != "[REDACTED]" || collections.GetPath(redactedMap, "config.nested.token") != "[REDACTED]" {
+		t.Errorf("Redact MapCollection failed: %v", config.All())
+	}
+
+	// A masked item that does not fit the item type becomes the zero value
+	whole := collections.NewRedactor("1").Redact(users).(*collections.Collection[map[string]any])
+	if whole.All()[1] != nil || whole.First()["name"] != "a" {
+		t.Error("Masked item should become the zero value")
+	}
+
+	users.RedactedDump(r)
+	m.RedactedDump(r)
+}
+
+func TestRedactorSlog(t *testing.T) {
+	r := collections.NewRedactor("body.password", "/token$/")
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{ReplaceAttr: r.ReplaceAttr}))
+
+	logger.Info("request",
+		"body", map[string]any{"user": "jane", "password": "secret"},
+		"access_token", "abc",
+		"users", r.LogValuer(collections.New([]any{map[string]any{"token": "t1"}})),
+	)
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatal(err)
+	}
+	if collections.Arr.Get(entry, "body.password") != "[REDACTED]" || collections.Arr.Get(entry, "body.user") != "jane" {
+		t.Errorf("ReplaceAttr nested failed: %v", entry["body"])
+	}
+	if entry["access_token"] != "[REDACTED]" {
+		t.Errorf("ReplaceAttr key failed: %v", entry["access_token"])
+	}
+	if collections.Arr.Get(entry, "users.0.token") != "[REDACTED]" {
+		t.Errorf("LogValuer failed: %v", entry["users"])
+	}
+}
+
+func TestRedactTypedContainers(t *testing.T) {
+	type Embedded struct {
+		Token string `json:"token"`
+	}
+	type Account struct {
+		*Embedded
+		Name     string `json:"name"`
+		Password string `json:"password"`
+		Pin      int    `json:"pin"`
+	}
+	account := &Account{Embedded: &Embedded{Token: "t"}, Name: "jane", Password: "p1", Pin: 1234}
+	data := map[string]any{
+		"headers":  map[string]string{"authorization": "Bearer abc", "accept": "json"},
+		"accounts": []map[string]any{{"password": "p2"}},
+		"account":  account,
+		"pins":     [2]Account{{Password: "p3"}},
+	}
+
+	redacted := collections.Arr.Redact(data, "**.password", "**.pin", "/^(authorization|token)$/")
+	headers := redacted["headers"].(map[string]string)
+	if headers["authorization"] != "[REDACTED]" || headers["accept"] != "json" {
+		t.Errorf("Redact typed map failed: %v", headers)
+	}
+	if redacted["accounts"].([]map[string]any)[0]["password"] != "[REDACTED]" {
+		t.Errorf("Redact typed slice failed: %v", redacted["accounts"])
+	}
+	got := redacted["account"].(*Account)
+	if got.Password != "[REDACTED]" || got.Token != "[REDACTED]" || got.Name != "jane" || got.Pin != 0 {
+		t.Errorf("Redact struct failed: %+v %+v", got, got.Embedded)
+	}
+	if redacted["pins"].([2]Account)[0].Password != "[REDACTED]" {
+		t.Errorf("Redact array failed: %v", redacted["pins"])
+	}
+	if account.Password != "p1" || account.Token != "t" || data["headers"].(map[string]string)["authorization"] != "Bearer abc" {
+		t.Error("Redact mutated input")
+	}
+}
+
+func TestRedactorSlogTypedContainers(t *testing.T) {
+	type Login struct {
+		User     string `json:"user"`
+		Password string `json:"password"`
+	}
+	r := collections.NewRedactor("**.password", "/^authorization$/")
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{ReplaceAttr: r.ReplaceAttr}))
+	logger.Info("request",
+		"headers", map[string]string{"authorization": "Bearer abc"},
+		"login", Login{User: "jane", Password: "secret"},
+	)
+
+	if strings.Contains(buf.String(), "Bearer abc") || strings.Contains(buf.String(), "secret") {
+		t.Errorf("ReplaceAttr leaked typed values: %s", buf.String())
+	}
+}
@@ -0,0 +1,293 @@
+package collections
+
+import (
+	"slices"
+	"sort"
+	"strings"
+)
+
+// trieNode is a single segment in a Trie.
+type trieNode[V any] struct {
+	children map[string]*trieNode[V]
+	order    []string // Maintains child insertion order
+	value    V
+	hasValue bool
+}
+
+func newTrieNode[V any]() *trieNode[V] {
+	return &trieNode[V]{children: make(map[string]*trieNode[V])}
+}
+
+// Trie is a prefix map for string keys. Keys are split into segments by a separator,
+// so with the default "." separator "db.host" is stored below "db".
+// An empty separator splits keys into individual characters.
+type Trie[V any] struct {
+	root      *trieNode[V]
+	separator string
+	size      int
+}
+
+// NewTrie creates a new Trie using "." as the segment separator.
+func NewTrie[V any]() *Trie[V] {
+	return NewTrieWithSeparator[V](".")
+}
+
+// NewTrieWithSeparator creates a new Trie that splits keys on the given separator.
+func NewTrieWithSeparator[V any](separator string) *Trie[V] {
+	return &Trie[V]{root: newTrieNode[V](), separator: separator}
+}
+
+// TrieFromDot creates a Trie from a flattened map such as the output of Arr.Dot.
+func TrieFromDot(data map[string]any) *Trie[any] {
+	t := NewTrie[any]()
+	keys := make([]string, 0, len(data))
+	for k := range data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		t.Put(k, data[k])
+	}
+	return t
+}
+
+// TrieFromNested creates a Trie from a nested map by flattening it with Arr.Dot.
+func TrieFromNested(data map[string]any) *Trie[any] {
+	return TrieFromDot(Arr.Dot(data))
+}
+
+// Separator returns the segment separator.
+func (t *Trie[V]) Separator() string {
+	return t.separator
+}
+
+// split splits a key into segments.
+func (t *Trie[V]) split(key string) []string {
+	if key == "" {
+		return []string{}
+	}
+	if t.separator == "" {
+		return strings.Split(key, "")
+	}
+	return strings.Split(key, t.separator)
+}
+
+// find returns the node for the given segments, or nil if it does not exist.
+func (t *Trie[V]) find(segments []string) *trieNode[V] {
+	node := t.root
+	for _, s := range segments {
+		next, ok := node.children[s]
+		if !ok {
+			return nil
+		}
+		node = next
+	}
+	return node
+}
+
+// Put sets the value for a key.
+func (t *Trie[V]) Put(key string, value V) *Trie[V] {
+	node := t.root
+	for _, s := range t.split(key) {
+		next, ok := node.children[s]
+		if !ok {
+			next = newTrieNode[V]()
+			node.children[s] = next
+			node.order = append(node.order, s)
+		}
+		node = next
+	}
+	if !node.hasValue {
+		t.size++
+	}
+	node.value = value
+	node.hasValue = true
+	return t
+}
+
+// Lookup returns the value for a key and whether it exists.
+func (t *Trie[V]) Lookup(key string) (V, bool) {
+	node := t.find(t.split(key))
+	if node == nil || !node.hasValue {
+		var zero V
+		return zero, false
+	}
+	return node.value, true
+}
+
+// Get returns the value for a key, or the zero value if missing.
+func (t *Trie[V]) Get(key string) V {
+	value, _ := t.Lookup(key)
+	return value
+}
+
+// GetOr returns the value for a key or a default value.
+func (t *Trie[V]) GetOr(key string, defaultValue V) V {
+	if value, ok := t.Lookup(key); ok {
+		return value
+	}
+	return defaultValue
+}
+
+// Has determines if all the keys exist.
+func (t *Trie[V]) Has(keys ...string) bool {
+	for _, key := range keys {
+		if _, ok := t.Lookup(key); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// HasPrefix determines if any key starts with the given segment prefix.
+func (t *Trie[V]) HasPrefix(prefix string) bool {
+	node := t.find(t.split(t.trimPrefix(prefix)))
+	return node != nil && (node.hasValue || len(node.children) > 0)
+}
+
+// Delete removes a key and reports whether it existed.
+func (t *Trie[V]) Delete(key string) bool {
+	segments := t.split(key)
+	path := make([]*trieNode[V], 0, len(segments)+1)
+	node := t.root
+	path = append(path, node)
+	for _, s := range segments {
+		next, ok := node.children[s]
+		if !ok {
+			return false
+		}
+		node = next
+		path = append(path, node)
+	}
+	if !node.hasValue {
+		return false
+	}
+
+	var zero V
+	node.value = zero
+	node.hasValue = false
+	t.size--
+
+	// Prune nodes that no longer hold values or children
+	for i := len(segments) - 1; i >= 0; i-- {
+		child := path[i+1]
+		if child.hasValue || len(child.children) > 0 {
+			break
+		}
+		parent := path[i]
+		delete(parent.children, segments[i])
+		parent.order = slices.DeleteFunc(parent.order, func(s string) bool { return s == segments[i] })
+	}
+	return true
+}
+
+// Count returns the number of keys.
+func (t *Trie[V]) Count() int {
+	return t.size
+}
+
+// IsEmpty determines if the trie is empty.
+func (t *Trie[V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Keys returns all keys in traversal order.
+func (t *Trie[V]) Keys() *Collection[string] {
+	return t.ToMap().Keys()
+}
+
+// Each iterates over each key-value pair in traversal order.
+func (t *Trie[V]) Each(callback func(string, V)) *Trie[V] {
+	t.walk(t.root, []string{}, func(segments []string, value V) {
+		callback(t.join(segments), value)
+	})
+	return t
+}
+
+// ToMap returns all entries as a MapCollection in traversal order.
+func (t *Trie[V]) ToMap() *MapCollection[string, V] {
+	return t.collect(t.root, []string{})
+}
+
+// WithPrefix returns all entries at or below the given segment prefix.
+// A trailing separator on the prefix is ignored, so "db." and "db" are equivalent.
+func (t *Trie[V]) WithPrefix(prefix string) *MapCollection[string, V] {
+	segments := t.split(t.trimPrefix(prefix))
+	node := t.find(segments)
+	if node == nil {
+		return NewMap[string, V](nil)
+	}
+	return t.collect(node, segments)
+}
+
+// LongestPrefixOf returns the longest stored key that is a segment prefix of s.
+func (t *Trie[V]) LongestPrefixOf(s string) (string, V, bool) {
+	segments := t.split(s)
+	node := t.root
+
+	var (
+		bestLen   = -1
+		bestValue V
+	)
+	if node.hasValue {
+		bestLen, bestValue = 0, node.value
+	}
+	for i, seg := range segments {
+		next, ok := node.children[seg]
+		if !ok {
+			break
+		}
+		node = next
+		if node.hasValue {
+			bestLen, bestValue = i+1, node.value
+		}
+	}
+
+	if bestLen < 0 {
+		var zero V
+		return "", zero, false
+	}
+	return t.join(segments[:bestLen]), bestValue, true
+}
+
+// ToDot converts the trie into a flat map with "." separated keys, as produced by Arr.Dot.
+func (t *Trie[V]) ToDot() map[string]any {
+	result := make(map[string]any, t.size)
+	t.walk(t.root, []string{}, func(segments []string, value V) {
+		result[strings.Join(segments, ".")] = value
+	})
+	return result
+}
+
+// Undot converts the trie into a nested map using Arr.Undot.
+func (t *Trie[V]) Undot() map[string]any {
+	return Arr.Undot(t.ToDot())
+}
+
+func (t *Trie[V]) trimPrefix(prefix string) string {
+	if t.separator == "" {
+		return prefix
+	}
+	return strings.TrimSuffix(prefix, t.separator)
+}
+
+func (t *Trie[V]) join(segments []string) string {
+	return strings.Join(segments, t.separator)
+}
+
+func (t *Trie[V]) collect(node *trieNode[V], prefix []string) *MapCollection[string, V] {
+	result := NewMap[string, V](nil)
+	t.walk(node, prefix, func(segments []string, value V) {
+		result.Put(t.join(segments), value)
+	})
+	return result
+}
+
+func (t *Trie[V]) walk(node *trieNode[V], prefix []string, visit func([]string, V)) {
+	if node.hasValue {
+		visit(prefix, node.value)
+	}
+	for _, s := range node.order {
+		t.walk(node.children[s], append(slices.Clip(prefix), s), visit)
+	}
+}
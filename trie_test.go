@@ -0,0 +1,101 @@
+package collections_test
+
+import (
+	"testing"
+
+	"github.com/qiuapeng921/collections"
+)
+
+func TestTriePutGetDelete(t *testing.T) {
+	trie := collections.NewTrie[int]()
+	trie.Put("db.host", 1).Put("db.port", 2).Put("db", 3).Put("cache.ttl", 4)
+
+	if trie.Get("db.host") != 1 || trie.Get("db") != 3 || trie.Count() != 4 {
+		t.Error("Trie Put/Get failed")
+	}
+	if trie.Has("db.user") || trie.GetOr("db.user", -1) != -1 {
+		t.Error("Trie missing key failed")
+	}
+	if !trie.Delete("db.host") || trie.Delete("db.host") || trie.Count() != 3 {
+		t.Error("Trie Delete failed")
+	}
+	if !trie.Has("db", "db.port") {
+		t.Error("Trie Delete removed siblings")
+	}
+	trie.Delete("cache.ttl")
+	if trie.HasPrefix("cache") {
+		t.Error("Trie Delete did not prune empty nodes")
+	}
+}
+
+func TestTrieWithPrefix(t *testing.T) {
+	trie := collections.NewTrie[string]()
+	trie.Put("db.host", "localhost").Put("db.port", "5432").Put("dbx.name", "x").Put("app.name", "demo")
+
+	under := trie.WithPrefix("db.")
+	keys := under.Keys().All()
+	if len(keys) != 2 || keys[0] != "db.host" || keys[1] != "db.port" {
+		t.Errorf("Trie WithPrefix failed: %v", keys)
+	}
+	if trie.WithPrefix("missing").Count() != 0 {
+		t.Error("Trie WithPrefix missing failed")
+	}
+	if trie.WithPrefix("").Count() != 4 {
+		t.Error("Trie WithPrefix empty failed")
+	}
+}
+
+func TestTrieLongestPrefixOf(t *testing.T) {
+	trie := collections.NewTrieWithSeparator[string]("/")
+	trie.Put("api", "root").Put("api/users", "users")
+
+	key, value, ok := trie.LongestPrefixOf("api/users/42")
+	if !ok || key != "api/users" || value != "users" {
+		t.Errorf("Trie LongestPrefixOf failed: %q %q", key, value)
+	}
+	key, _, ok = trie.LongestPrefixOf("api/orders")
+	if !ok || key != "api" {
+		t.Error("Trie LongestPrefixOf shorter match failed")
+	}
+	if _, _, ok := trie.LongestPrefixOf("web"); ok {
+		t.Error("Trie LongestPrefixOf no match failed")
+	}
+}
+
+func TestTrieCharacterSeparator(t *testing.T) {
+	trie := collections.NewTrieWithSeparator[int]("")
+	trie.Put("car", 1).Put("cart", 2).Put("dog", 3)
+
+	if trie.WithPrefix("ca").Count() != 2 {
+		t.Error("Trie character WithPrefix failed")
+	}
+	if key, _, _ := trie.LongestPrefixOf("carton"); key != "cart" {
+		t.Errorf("Trie character LongestPrefixOf failed: %q", key)
+	}
+}
+
+func TestTrieDotRoundTrip(t *testing.T) {
+	nested := map[string]any{
+		"db":  map[string]any{"host": "localhost", "port": 5432},
+		"app": "demo",
+	}
+	trie := collections.TrieFromNested(nested)
+	if trie.Get("db.port") != 5432 || trie.Count() != 3 {
+		t.Error("TrieFromNested failed")
+	}
+
+	dot := trie.ToDot()
+	if dot["db.host"] != "localhost" || len(dot) != 3 {
+		t.Error("Trie ToDot failed")
+	}
+	undot := trie.Undot()
+	if collections.Arr.Get(undot, "db.host") != "localhost" {
+		t.Error("Trie Undot failed")
+	}
+
+	slashed := collections.NewTrieWithSeparator[int]("/")
+	slashed.Put("a/b", 1)
+	if slashed.ToDot()["a.b"] != 1 {
+		t.Error("Trie ToDot with custom separator failed")
+	}
+}
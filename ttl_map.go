@@ -0,0 +1,320 @@
+package collections
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// Clock provides the current time.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is a Clock backed by time.Now.
+type SystemClock struct{}
+
+// Now returns the current wall-clock time.
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// ttlEntry holds a value together with its expiry information.
+type ttlEntry[V any] struct {
+	value     V
+	ttl       time.Duration
+	expiresAt time.Time // Zero means the entry never expires
+}
+
+func (e ttlEntry[V]) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+// TTLMap is an insertion-ordered key-value collection whose entries expire after a time-to-live.
+// Expired entries are invisible to reads and are removed lazily on access or by Sweep.
+// TTLMap is safe for concurrent use.
+type TTLMap[K comparable, V any] struct {
+	mu            sync.Mutex
+	items         map[K]ttlEntry[V]
+	keys          []K // Maintains insertion order
+	defaultTTL    time.Duration
+	clock         Clock
+	refreshOnRead bool
+	onExpire      func(K, V)
+	stop          chan struct{}
+}
+
+// NewTTLMap creates a new TTLMap using defaultTTL for Put. A TTL of zero or less never expires.
+func NewTTLMap[K comparable, V any](defaultTTL time.Duration) *TTLMap[K, V] {
+	return &TTLMap[K, V]{
+		items:      make(map[K]ttlEntry[V]),
+		keys:       make([]K, 0),
+		defaultTTL: defaultTTL,
+		clock:      SystemClock{},
+	}
+}
+
+// WithClock sets the clock used to compute expiry.
+func (m *TTLMap[K, V]) WithClock(clock Clock) *TTLMap[K, V] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if clock == nil {
+		clock = SystemClock{}
+	}
+	m.clock = clock
+	return m
+}
+
+// RefreshOnRead makes successful reads extend an entry's lifetime by its TTL.
+func (m *TTLMap[K, V]) RefreshOnRead(enabled bool) *TTLMap[K, V] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.refreshOnRead = enabled
+	return m
+}
+
+// OnExpire registers a callback invoked for every entry removed because it expired.
+func (m *TTLMap[K, V]) OnExpire(callback func(K, V)) *TTLMap[K, V] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.onExpire = callback
+	return m
+}
+
+// Put sets a key-value pair using the default TTL.
+func (m *TTLMap[K, V]) Put(key K, value V) *TTLMap[K, V] {
+	return m.PutWithTTL(key, value, m.defaultTTL)
+}
+
+// PutWithTTL sets a key-value pair that expires after ttl. A TTL of zero or less never expires.
+func (m *TTLMap[K, V]) PutWithTTL(key K, value V, ttl time.Duration) *TTLMap[K, V] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry := ttlEntry[V]{value: value, ttl: ttl}
+	if ttl > 0 {
+		entry.expiresAt = m.clock.Now().Add(ttl)
+	}
+	if _, exists := m.items[key]; !exists {
+		m.keys = append(m.keys, key)
+	}
+	m.items[key] = entry
+	return m
+}
+
+// Lookup returns the value for the key and whether it exists and has not expired.
+func (m *TTLMap[K, V]) Lookup(key K) (V, bool) {
+	m.mu.Lock()
+	entry, ok := m.items[key]
+	if !ok {
+		m.mu.Unlock()
+		var zero V
+		return zero, false
+	}
+	now := m.clock.Now()
+	if entry.expired(now) {
+		m.remove(key)
+		callback := m.onExpire
+		m.mu.Unlock()
+		if callback != nil {
+			callback(key, entry.value)
+		}
+		var zero V
+		return zero, false
+	}
+	if m.refreshOnRead && entry.ttl > 0 {
+		entry.expiresAt = now.Add(entry.ttl)
+		m.items[key] = entry
+	}
+	m.mu.Unlock()
+	return entry.value, true
+}
+
+// Get returns the value for the given key, or the zero value if missing or expired.
+func (m *TTLMap[K, V]) Get(key K) V {
+	value, _ := m.Lookup(key)
+	return value
+}
+
+// GetOr returns the value for the key or a default value if missing or expired.
+func (m *TTLMap[K, V]) GetOr(key K, defaultValue V) V {
+	if value, ok := m.Lookup(key); ok {
+		return value
+	}
+	return defaultValue
+}
+
+// Has determines if all the keys exist and have not expired.
+func (m *TTLMap[K, V]) Has(keys ...K) bool {
+	for _, key := range keys {
+		if _, ok := m.Lookup(key); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// TTL returns the remaining lifetime of a key. The boolean is false if the key is missing or expired;
+// a remaining duration of zero with true means the entry never expires.
+func (m *TTLMap[K, V]) TTL(key K) (time.Duration, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.items[key]
+	if !ok {
+		return 0, false
+	}
+	now := m.clock.Now()
+	if entry.expired(now) {
+		return 0, false
+	}
+	if entry.expiresAt.IsZero() {
+		return 0, true
+	}
+	return entry.expiresAt.Sub(now), true
+}
+
+// Touch resets the expiry of a live key to now plus its TTL.
+func (m *TTLMap[K, V]) Touch(key K) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.items[key]
+	now := m.clock.Now()
+	if !ok || entry.expired(now) {
+		return false
+	}
+	if entry.ttl > 0 {
+		entry.expiresAt = now.Add(entry.ttl)
+		m.items[key] = entry
+	}
+	return true
+}
+
+// Forget removes one or more keys without firing the expiry callback.
+func (m *TTLMap[K, V]) Forget(keys ...K) *TTLMap[K, V] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, key := range keys {
+		m.remove(key)
+	}
+	return m
+}
+
+// Keys returns all live keys in insertion order.
+func (m *TTLMap[K, V]) Keys() *Collection[K] {
+	m.Sweep()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return New(slices.Clone(m.keys))
+}
+
+// Values returns all live values in insertion order.
+func (m *TTLMap[K, V]) Values() *Collection[V] {
+	return m.ToMap().Values()
+}
+
+// Count returns the number of live items.
+func (m *TTLMap[K, V]) Count() int {
+	m.Sweep()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.items)
+}
+
+// IsEmpty determines if the map has no live items.
+func (m *TTLMap[K, V]) IsEmpty() bool {
+	return m.Count() == 0
+}
+
+// Each iterates over each live item in insertion order.
+func (m *TTLMap[K, V]) Each(callback func(K, V)) *TTLMap[K, V] {
+	m.ToMap().Each(callback)
+	return m
+}
+
+// ToMap returns a snapshot of the live items as a MapCollection.
+func (m *TTLMap[K, V]) ToMap() *MapCollection[K, V] {
+	m.Sweep()
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := make(map[K]V, len(m.items))
+	for k, entry := range m.items {
+		result[k] = entry.value
+	}
+	return NewMapOrdered(result, m.keys)
+}
+
+// Sweep removes all expired entries, fires the expiry callback for each, and returns how many were removed.
+func (m *TTLMap[K, V]) Sweep() int {
+	m.mu.Lock()
+	now := m.clock.Now()
+	expired := make([]KeyValue[K, V], 0)
+	for _, k := range m.keys {
+		if entry := m.items[k]; entry.expired(now) {
+			expired = append(expired, KeyValue[K, V]{Key: k, Value: entry.value})
+		}
+	}
+	for _, kv := range expired {
+		m.remove(kv.Key)
+	}
+	callback := m.onExpire
+	m.mu.Unlock()
+
+	if callback != nil {
+		for _, kv := range expired {
+			callback(kv.Key, kv.Value)
+		}
+	}
+	return len(expired)
+}
+
+// StartSweeper starts a background goroutine that calls Sweep every interval.
+// Any previously started sweeper is stopped first.
+func (m *TTLMap[K, V]) StartSweeper(interval time.Duration) *TTLMap[K, V] {
+	m.StopSweeper()
+	if interval <= 0 {
+		return m
+	}
+
+	stop := make(chan struct{})
+	m.mu.Lock()
+	m.stop = stop
+	m.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				m.Sweep()
+			case <-stop:
+				return
+			}
+		}
+	}()
+	return m
+}
+
+// StopSweeper stops the background sweeper if one is running.
+func (m *TTLMap[K, V]) StopSweeper() *TTLMap[K, V] {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.stop != nil {
+		close(m.stop)
+		m.stop = nil
+	}
+	return m
+}
+
+// remove deletes a key; the caller must hold the lock.
+func (m *TTLMap[K, V]) remove(key K) {
+	if _, ok := m.items[key]; !ok {
+		return
+	}
+	delete(m.items, key)
+	for i, k := range m.keys {
+		if k == key {
+			m.keys = append(m.keys[:i], m.keys[i+1:]...)
+			break
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package collections_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/qiuapeng921/collections"
+)
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestTTLMapExpiry(t *testing.T) {
+	clock := newFakeClock()
+	m := collections.NewTTLMap[string, int](time.Minute).WithClock(clock)
+	m.Put("a", 1).PutWithTTL("b", 2, 2*time.Minute).PutWithTTL("c", 3, 0)
+
+	if m.Get("a") != 1 || !m.Has("a", "b", "c") {
+		t.Error("TTLMap Get before expiry failed")
+	}
+
+	clock.Advance(time.Minute)
+	if m.Has("a") || m.GetOr("a", -1) != -1 {
+		t.Error("TTLMap expired key still visible")
+	}
+	keys := m.Keys().All()
+	if len(keys) != 2 || keys[0] != "b" || keys[1] != "c" {
+		t.Errorf("TTLMap Keys failed: %v", keys)
+	}
+
+	clock.Advance(time.Hour)
+	if m.Count() != 1 || m.Get("c") != 3 {
+		t.Error("TTLMap non-expiring entry failed")
+	}
+}
+
+func TestTTLMapRefreshOnRead(t *testing.T) {
+	clock := newFakeClock()
+	m := collections.NewTTLMap[string, int](time.Minute).WithClock(clock).RefreshOnRead(true)
+	m.Put("a", 1)
+
+	clock.Advance(50 * time.Second)
+	if !m.Has("a") {
+		t.Fatal("TTLMap entry expired early")
+	}
+	clock.Advance(50 * time.Second)
+	if !m.Has("a") {
+		t.Error("TTLMap RefreshOnRead did not extend lifetime")
+	}
+	remaining, ok := m.TTL("a")
+	if !ok || remaining != time.Minute {
+		t.Errorf("TTLMap TTL failed: %v %v", remaining, ok)
+	}
+}
+
+func TestTTLMapOnExpire(t *testing.T) {
+	clock := newFakeClock()
+	expired := map[string]int{}
+	m := collections.NewTTLMap[string, int](time.Second).
+		WithClock(clock).
+		OnExpire(func(k string, v int) { expired[k] = v })
+	m.Put("a", 1).Put("b", 2).PutWithTTL("c", 3, time.Hour)
+
+	clock.Advance(time.Second)
+	if n := m.Sweep(); n != 2 {
+		t.Errorf("TTLMap Sweep expected 2, got %d", n)
+	}
+	if expired["a"] != 1 || expired["b"] != 2 || len(expired) != 2 {
+		t.Errorf("TTLMap OnExpire failed: %v", expired)
+	}
+
+	m.Forget("c")
+	if len(expired) != 2 || !m.IsEmpty() {
+		t.Error("TTLMap Forget should not fire OnExpire")
+	}
+}
+
+func TestTTLMapLazyExpireCallback(t *testing.T) {
+	clock := newFakeClock()
+	fired := 0
+	m := collections.NewTTLMap[string, int](time.Second).
+		WithClock(clock).
+		OnExpire(func(string, int) { fired++ })
+	m.Put("a", 1)
+	clock.Advance(2 * time.Second)
+	if _, ok := m.Lookup("a"); ok || fired != 1 {
+		t.Error("TTLMap lazy expiry failed")
+	}
+}
+
+func TestTTLMapToMap(t *testing.T) {
+	clock := newFakeClock()
+	m := collections.NewTTLMap[string, int](time.Second).WithClock(clock)
+	m.Put("x", 1).PutWithTTL("y", 2, 0)
+	clock.Advance(time.Second)
+
+	snapshot := m.ToMap()
+	if snapshot.Count() != 1 || snapshot.Get("y") != 2 {
+		t.Error("TTLMap ToMap failed")
+	}
+	if m.Values().First() != 2 {
+		t.Error("TTLMap Values failed")
+	}
+}
+
+func TestTTLMapSweeper(t *testing.T) {
+	clock := newFakeClock()
+	done := make(chan string, 1)
+	m := collections.NewTTLMap[string, int](time.Second).
+		WithClock(clock).
+		OnExpire(func(k string, _ int) { done <- k })
+	m.Put("a", 1)
+	clock.Advance(time.Second)
+
+	m.StartSweeper(time.Millisecond)
+	defer m.StopSweeper()
+
+	select {
+	case k := <-done:
+		if k != "a" {
+			t.Errorf("TTLMap sweeper expired wrong key %q", k)
+		}
+	case <-time.After(time.Second):
+		t.Error("TTLMap sweeper did not run")
+	}
+}
@@ -0,0 +1,474 @@
+package collections
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+	timeType     = reflect.TypeOf(time.Time{})
+)
+
+// timeLayouts are the layouts tried when leniently parsing a time string.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	time.RFC1123Z,
+	time.RFC1123,
+}
+
+// GetAs retrieves the value at a dot-notation path and returns it as T.
+// The value must already be a T, except that strings are parsed for time.Duration and
+// RFC 3339 time.Time targets. It returns an ItemNotFoundException if the path is missing
+// and a TypeMismatchException if the value has another type.
+func GetAs[T any](data any, path string) (T, error) {
+	return getAs[T](data, path, false)
+}
+
+// GetAsLenient is like GetAs but coerces compatible values, for example "42" or 42.0 to an int,
+// "true" or 1 to a bool, numbers to strings, and numbers of seconds to a time.Duration.
+func GetAsLenient[T any](data any, path string) (T, error) {
+	return getAs[T](data, path, true)
+}
+
+func getAs[T any](data any, path string, lenient bool) (T, error) {
+	var zero T
+	value, ok := lookupAny(data, path)
+	if !ok {
+		return zero, &ItemNotFoundException{Message: fmt.Sprintf("path %q not found", path)}
+	}
+	if v, ok := value.(T); ok {
+		return v, nil
+	}
+
+	target := reflect.TypeOf(&zero).Elem()
+	converted, err := coerce(value, target, lenient)
+	if err != nil {
+		return zero, &TypeMismatchException{Path: path, Expected: target.String(), Actual: fmt.Sprintf("%T", value)}
+	}
+	return converted.Interface().(T), nil
+}
+
+// lookupAny resolves a path with DataGet semantics, reporting whether it exists.
+func lookupAny(data any, path string) (any, bool) {
+	if path == "" {
+		return data, data != nil
+	}
+	if m, ok := data.(map[string]any); ok {
+		if v, ok := m[path]; ok {
+			return v, true
+		}
+	}
+	return pathGet(data, splitPath(path))
+}
+
+// coerce converts value to type t. Without lenient only exact types, duration strings
+// and RFC 3339 time strings are accepted.
+func coerce(value any, t reflect.Type, lenient bool) (reflect.Value, error) {
+	if value == nil {
+		return reflect.Value{}, fmt.Errorf("value is nil")
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Type().AssignableTo(t) {
+		result := reflect.New(t).Elem()
+		result.Set(rv)
+		return result, nil
+	}
+
+	switch t {
+	case durationType:
+		d, err := coerceDuration(value, lenient)
+		return reflect.ValueOf(d), err
+	case timeType:
+		tm, err := coerceTime(value, lenient)
+		return reflect.ValueOf(tm), err
+	}
+	if (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) && (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array) {
+		if t.Kind() == reflect.Array && rv.Len() != t.Len() {
+			return reflect.Value{}, fmt.Errorf("length %d does not match %s", rv.Len(), t)
+		}
+		items := make([]any, rv.Len())
+		for i := range items {
+			items[i] = rv.Index(i).Interface()
+		}
+		return coerceSlice(items, t, lenient)
+	}
+	if !lenient {
+		return reflect.Value{}, fmt.Errorf("lenient coercion is disabled")
+	}
+
+	result := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.String:
+		s, err := coerceString(value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		result.SetString(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := coerceInt(value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if result.OverflowInt(n) {
+			return reflect.Value{}, fmt.Errorf("%d is out of range for %s", n, t)
+		}
+		result.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := coerceUint(value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if result.OverflowUint(n) {
+			return reflect.Value{}, fmt.Errorf("%d is out of range for %s", n, t)
+		}
+		result.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := coerceNumber(value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		result.SetFloat(f)
+	case reflect.Bool:
+		b, err := coerceBool(value)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		result.SetBool(b)
+	case reflect.Slice:
+		return coerceSlice([]any{value}, t, lenient)
+	default:
+		if rv.Kind() == reflect.Slice && t.Kind() == reflect.Pointer && t.Elem().Kind() == reflect.Array && rv.Len() < t.Elem().Len() {
+			return reflect.Value{}, fmt.Errorf("length %d is too short for %s", rv.Len(), t)
+		}
+		if rv.Type().ConvertibleTo(t) {
+			return rv.Convert(t), nil
+		}
+		return reflect.Value{}, fmt.Errorf("no conversion available")
+	}
+	return result, nil
+}
+
+// coerceSlice converts each item to the element type of t, which is a slice type or an
+// array type of the same length as items.
+func coerceSlice(items []any, t reflect.Type, lenient bool) (reflect.Value, error) {
+	result := reflect.New(t).Elem()
+	if t.Kind() == reflect.Slice {
+		result = reflect.MakeSlice(t, len(items), len(items))
+	}
+	for i, item := range items {
+		elem, err := coerce(item, t.Elem(), lenient)
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("element %d: %w", i, err)
+		}
+		result.Index(i).Set(elem)
+	}
+	return result, nil
+}
+
+// coerceString formats scalars as strings.
+func coerceString(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case json.Number:
+		return v.String(), nil
+	case fmt.Stringer:
+		return v.String(), nil
+	}
+	if isNumericKind(reflect.ValueOf(value).Kind()) {
+		return fmt.Sprint(value), nil
+	}
+	return "", fmt.Errorf("not a scalar")
+}
+
+// coerceNumber converts numbers, numeric strings and booleans to float64.
+func coerceNumber(value any) (float64, error) {
+	switch v := value.(type) {
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not numeric", v)
+		}
+		return f, nil
+	case json.Number:
+		return v.Float64()
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	}
+	return 0, fmt.Errorf("not numeric")
+}
+
+// coerceInt converts integers, whole floats and numeric strings to int64 without losing
+// precision. Integer strings are parsed exactly; other numbers must be whole and in range.
+func coerceInt(value any) (int64, error) {
+	switch v := value.(type) {
+	case string, json.Number:
+		s := strings.TrimSpace(fmt.Sprint(v))
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err == nil {
+			return n, nil
+		}
+		if errors.Is(err, strconv.ErrRange) {
+			return 0, fmt.Errorf("%q is out of range for int64", s)
+		}
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if rv.Uint() > math.MaxInt64 {
+			return 0, fmt.Errorf("%d is out of range for int64", rv.Uint())
+		}
+		return int64(rv.Uint()), nil
+	}
+	f, err := coerceNumber(value)
+	if err != nil {
+		return 0, err
+	}
+	if f != math.Trunc(f) || f < -(1<<63) || f >= 1<<63 {
+		return 0, fmt.Errorf("%v is not a whole number in range", f)
+	}
+	return int64(f), nil
+}
+
+// coerceUint is coerceInt for unsigned integers.
+func coerceUint(value any) (uint64, error) {
+	switch v := value.(type) {
+	case string, json.Number:
+		s := strings.TrimSpace(fmt.Sprint(v))
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err == nil {
+			return n, nil
+		}
+		if errors.Is(err, strconv.ErrRange) {
+			return 0, fmt.Errorf("%q is out of range for uint64", s)
+		}
+	}
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if rv.Int() < 0 {
+			return 0, fmt.Errorf("%d is negative", rv.Int())
+		}
+		return uint64(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return rv.Uint(), nil
+	}
+	f, err := coerceNumber(value)
+	if err != nil {
+		return 0, err
+	}
+	if f != math.Trunc(f) || f < 0 || f >= 1<<64 {
+		return 0, fmt.Errorf("%v is not a non-negative whole number in range", f)
+	}
+	return uint64(f), nil
+}
+
+// coerceBool converts booleans, numbers and strings such as "yes" or "off" to bool.
+func coerceBool(value any) (bool, error) {
+	if s, ok := value.(string); ok {
+		switch strings.ToLower(strings.TrimSpace(s)) {
+		case "1", "t", "true", "y", "yes", "on":
+			return true, nil
+		case "0", "f", "false", "n", "no", "off", "":
+			return false, nil
+		}
+		return false, fmt.Errorf("%q is not a boolean", s)
+	}
+	f, err := coerceNumber(value)
+	if err != nil {
+		return false, fmt.Errorf("not a boolean")
+	}
+	return f != 0, nil
+}
+
+// maxSeconds is the largest number of seconds a time.Duration can hold.
+const maxSeconds = float64(math.MaxInt64 / time.Second)
+
+// coerceSeconds converts a number of seconds, rejecting NaN and values a time.Duration cannot hold.
+func coerceSeconds(value any) (float64, error) {
+	seconds, err := coerceNumber(value)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(seconds) || seconds < -maxSeconds || seconds > maxSeconds {
+		return 0, fmt.Errorf("%v seconds is out of range", seconds)
+	}
+	return seconds, nil
+}
+
+// coerceDuration parses duration strings; leniently, numbers and numeric strings are seconds.
+func coerceDuration(value any, lenient bool) (time.Duration, error) {
+	if s, ok := value.(string); ok {
+		if d, err := time.ParseDuration(strings.TrimSpace(s)); err == nil {
+			return d, nil
+		}
+		if !lenient {
+			return 0, fmt.Errorf("%q is not a duration", s)
+		}
+	}
+	if !lenient {
+		return 0, fmt.Errorf("lenient coercion is disabled")
+	}
+	seconds, err := coerceSeconds(value)
+	if err != nil {
+		return 0, fmt.Errorf("not a duration: %w", err)
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+// coerceTime parses RFC 3339 strings; leniently, other common layouts and unix seconds are accepted.
+func coerceTime(value any, lenient bool) (time.Time, error) {
+	if s, ok := value.(string); ok {
+		s = strings.TrimSpace(s)
+		layouts := timeLayouts[:2]
+		if lenient {
+			layouts = timeLayouts
+		}
+		for _, layout := range layouts {
+			if t, err := time.Parse(layout, s); err == nil {
+				return t, nil
+			}
+		}
+		if !lenient {
+			return time.Time{}, fmt.Errorf("%q is not an RFC 3339 time", s)
+		}
+	}
+	if !lenient {
+		return time.Time{}, fmt.Errorf("lenient coercion is disabled")
+	}
+	seconds, err := coerceSeconds(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("not a time: %w", err)
+	}
+	whole, frac := math.Modf(seconds)
+	return time.Unix(int64(whole), int64(frac*float64(time.Second))).UTC(), nil
+}
+
+// Lenient returns Arr helpers whose typed getters coerce compatible values, as GetAsLenient does.
+func (a ArrHelpers) Lenient() ArrHelpers {
+	a.lenient = true
+	return a
+}
+
+// typedGet retrieves a typed value using the helper's coercion mode.
+func typedGet[T any](a ArrHelpers, data map[string]any, key string) (T, error) {
+	return getAs[T](data, key, a.lenient)
+}
+
+// typedOr returns the typed value, or the default (or zero value) on any error.
+func typedOr[T any](a ArrHelpers, data map[string]any, key string, defaultValue []T) T {
+	if v, err := typedGet[T](a, data, key); err == nil {
+		return v
+	}
+	if len(defaultValue) > 0 {
+		return defaultValue[0]
+	}
+	var zero T
+	return zero
+}
+
+// String returns the string at key, or the default if it is missing or not a string.
+func (a ArrHelpers) String(data map[string]any, key string, defaultValue ...string) string {
+	return typedOr(a, data, key, defaultValue)
+}
+
+// StringOrFail returns the string at key or an error explaining why it is unavailable.
+func (a ArrHelpers) StringOrFail(data map[string]any, key string) (string, error) {
+	return typedGet[string](a, data, key)
+}
+
+// Int returns the int at key, or the default if it is missing or not an int.
+func (a ArrHelpers) Int(data map[string]any, key string, defaultValue ...int) int {
+	return typedOr(a, data, key, defaultValue)
+}
+
+// IntOrFail returns the int at key or an error explaining why it is unavailable.
+func (a ArrHelpers) IntOrFail(data map[string]any, key string) (int, error) {
+	return typedGet[int](a, data, key)
+}
+
+// Float returns the float64 at key, or the default if it is missing or not a float64.
+func (a ArrHelpers) Float(data map[string]any, key string, defaultValue ...float64) float64 {
+	return typedOr(a, data, key, defaultValue)
+}
+
+// FloatOrFail returns the float64 at key or an error explaining why it is unavailable.
+func (a ArrHelpers) FloatOrFail(data map[string]any, key string) (float64, error) {
+	return typedGet[float64](a, data, key)
+}
+
+// Bool returns the bool at key, or the default if it is missing or not a bool.
+func (a ArrHelpers) Bool(data map[string]any, key string, defaultValue ...bool) bool {
+	return typedOr(a, data, key, defaultValue)
+}
+
+// BoolOrFail returns the bool at key or an error explaining why it is unavailable.
+func (a ArrHelpers) BoolOrFail(data map[string]any, key string) (bool, error) {
+	return typedGet[bool](a, data, key)
+}
+
+// Duration returns the duration at key, or the default if it is missing or invalid.
+// Duration strings such as "1m30s" are always parsed.
+func (a ArrHelpers) Duration(data map[string]any, key string, defaultValue ...time.Duration) time.Duration {
+	return typedOr(a, data, key, defaultValue)
+}
+
+// DurationOrFail returns the duration at key or an error explaining why it is unavailable.
+func (a ArrHelpers) DurationOrFail(data map[string]any, key string) (time.Duration, error) {
+	return typedGet[time.Duration](a, data, key)
+}
+
+// Time returns the time at key, or the default if it is missing or invalid.
+// RFC 3339 strings are always parsed.
+func (a ArrHelpers) Time(data map[string]any, key string, defaultValue ...time.Time) time.Time {
+	return typedOr(a, data, key, defaultValue)
+}
+
+// TimeOrFail returns the time at key or an error explaining why it is unavailable.
+func (a ArrHelpers) TimeOrFail(data map[string]any, key string) (time.Time, error) {
+	return typedGet[time.Time](a, data, key)
+}
+
+// StringSlice returns the strings at key, or the default if it is missing or invalid.
+// A []any of strings is accepted; leniently, elements are coerced and a single value is wrapped.
+func (a ArrHelpers) StringSlice(data map[string]any, key string, defaultValue ...[]string) []string {
+	return typedOr(a, data, key, defaultValue)
+}
+
+// StringSliceOrFail returns the strings at key or an error explaining why they are unavailable.
+func (a ArrHelpers) StringSliceOrFail(data map[string]any, key string) ([]string, error) {
+	return typedGet[[]string](a, data, key)
+}
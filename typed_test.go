@@ -0,0 +1,234 @@
+package collections_test
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/qiuapeng921/collections"
+)
+
+func TestArrString(t *testing.T) {
+	data := map[string]any{"app": map[string]any{"name": "demo", "port": 8080}}
+	if collections.Arr.String(data, "app.name") != "demo" {
+		t.Error("String failed")
+	}
+	if collections.Arr.String(data, "app.port") != "" {
+		t.Error("Zero value fallback failed")
+	}
+}
+
+func TestArrInt(t *testing.T) {
+	data := map[string]any{"port": 8080, "env": "9090"}
+	if collections.Arr.Int(data, "port") != 8080 {
+		t.Error("Int failed")
+	}
+	if collections.Arr.Int(data, "env", -1) != -1 {
+		t.Error("Strict Int should not parse strings")
+	}
+	if collections.Arr.Int(data, "missing", 7) != 7 {
+		t.Error("Default failed")
+	}
+}
+
+func TestArrFloatAndBool(t *testing.T) {
+	data := map[string]any{"ratio": 0.5, "debug": true}
+	if collections.Arr.Float(data, "ratio") != 0.5 {
+		t.Error("Float failed")
+	}
+	if !collections.Arr.Bool(data, "debug") {
+		t.Error("Bool failed")
+	}
+}
+
+func TestArrDuration(t *testing.T) {
+	data := map[string]any{"timeout": "1m30s"}
+	if collections.Arr.Duration(data, "timeout") != 90*time.Second {
+		t.Error("Duration string failed")
+	}
+}
+
+func TestArrTime(t *testing.T) {
+	data := map[string]any{"started": "2024-05-01T10:00:00Z"}
+	if !collections.Arr.Time(data, "started").Equal(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Error("Time RFC 3339 failed")
+	}
+}
+
+func TestArrStringSlice(t *testing.T) {
+	data := map[string]any{"tags": []any{"a", "b"}, "ids": []any{1, "2"}}
+	if !reflect.DeepEqual(collections.Arr.StringSlice(data, "tags"), []string{"a", "b"}) {
+		t.Error("StringSlice from []any failed")
+	}
+	if collections.Arr.StringSlice(data, "ids") != nil {
+		t.Error("Strict StringSlice should reject non-strings")
+	}
+}
+
+func TestArrIntOrFailTypeMismatch(t *testing.T) {
+	data := map[string]any{"env": map[string]any{"port": "9090"}}
+	_, err := collections.Arr.IntOrFail(data, "env.port")
+	var mismatch *collections.TypeMismatchException
+	if !errors.As(err, &mismatch) {
+		t.Fatalf("Expected TypeMismatchException, got %v", err)
+	}
+	if mismatch.Path != "env.port" || mismatch.Expected != "int" || mismatch.Actual != "string" {
+		t.Errorf("Unexpected mismatch details: %+v", mismatch)
+	}
+}
+
+func TestArrStringOrFailMissing(t *testing.T) {
+	data := map[string]any{"app": map[string]any{}}
+	_, err := collections.Arr.StringOrFail(data, "app.missing")
+	var notFound *collections.ItemNotFoundException
+	if !errors.As(err, &notFound) {
+		t.Errorf("Expected ItemNotFoundException, got %v", err)
+	}
+}
+
+func TestArrLenientIntRejectsFraction(t *testing.T) {
+	data := map[string]any{"half": 2.5}
+	if _, err := collections.Arr.Lenient().IntOrFail(data, "half"); err == nil {
+		t.Error("Fractional float should not coerce to int")
+	}
+}
+
+func TestArrLenientNumbers(t *testing.T) {
+	data := map[string]any{"port": "9090", "workers": 4.0, "code": 8080}
+	lenient := collections.Arr.Lenient()
+	if lenient.Int(data, "port") != 9090 {
+		t.Error("Lenient Int from string failed")
+	}
+	if lenient.Int(data, "workers") != 4 {
+		t.Error("Lenient Int from whole float failed")
+	}
+	if lenient.Float(data, "port") != 9090 {
+		t.Error("Lenient Float from string failed")
+	}
+	if lenient.String(data, "code") != "8080" {
+		t.Error("Lenient String from int failed")
+	}
+}
+
+func TestArrLenientBool(t *testing.T) {
+	data := map[string]any{"debug": "yes"}
+	if !collections.Arr.Lenient().Bool(data, "debug") {
+		t.Error("Lenient Bool from yes failed")
+	}
+}
+
+func TestArrLenientDurationAndTime(t *testing.T) {
+	data := map[string]any{"ttl": 30, "since": "2024-05-01"}
+	lenient := collections.Arr.Lenient()
+	if lenient.Duration(data, "ttl") != 30*time.Second {
+		t.Error("Lenient Duration from seconds failed")
+	}
+	if !lenient.Time(data, "since").Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Lenient Time from date failed")
+	}
+}
+
+func TestArrLenientStringSlice(t *testing.T) {
+	data := map[string]any{"hosts": "only-one", "ids": []any{1, 2.0, "3"}}
+	lenient := collections.Arr.Lenient()
+	if !reflect.DeepEqual(lenient.StringSlice(data, "hosts"), []string{"only-one"}) {
+		t.Error("Lenient StringSlice should wrap a single value")
+	}
+	if !reflect.DeepEqual(lenient.StringSlice(data, "ids"), []string{"1", "2", "3"}) {
+		t.Error("Lenient StringSlice should coerce elements")
+	}
+}
+
+func TestGetAs(t *testing.T) {
+	m := collections.NewMap(map[string]any{"app": map[string]any{"port": 8080}, "env": map[string]any{"port": "9090"}})
+	port, err := collections.GetAs[int](m, "app.port")
+	if err != nil || port != 8080 {
+		t.Errorf("GetAs failed: %v %v", port, err)
+	}
+	if _, err := collections.GetAs[int](m, "env.port"); err == nil {
+		t.Error("GetAs should not coerce")
+	}
+}
+
+func TestGetAsLenient(t *testing.T) {
+	m := collections.NewMap(map[string]any{"port": "9090", "ids": []any{1, 2.0, "3"}, "name": "demo"})
+	n, err := collections.GetAsLenient[int64](m, "port")
+	if err != nil || n != 9090 {
+		t.Errorf("GetAsLenient failed: %v %v", n, err)
+	}
+	ids, err := collections.GetAsLenient[[]int](m, "ids")
+	if err != nil || !reflect.DeepEqual(ids, []int{1, 2, 3}) {
+		t.Errorf("GetAsLenient slice failed: %v %v", ids, err)
+	}
+	if _, err := collections.GetAsLenient[bool](m, "name"); err == nil {
+		t.Error("GetAsLenient should reject non-boolean strings")
+	}
+}
+
+func TestGetAsLenientIntBoundaries(t *testing.T) {
+	data := map[string]any{
+		"huge":     1e20,
+		"maxStr":   "9223372036854775807",
+		"overStr":  "9223372036854775808",
+		"maxUint":  "18446744073709551615",
+		"bigUint":  uint64(math.MaxUint64),
+		"negative": -1,
+		"edge":     float64(1 << 63),
+	}
+
+	if n, err := collections.GetAsLenient[int](data, "huge"); err == nil {
+		t.Errorf("GetAsLenient[int] should reject 1e20, got %d", n)
+	}
+	if n, err := collections.GetAsLenient[int64](data, "maxStr"); err != nil || n != math.MaxInt64 {
+		t.Errorf("GetAsLenient[int64] max string failed: %d %v", n, err)
+	}
+	if _, err := collections.GetAsLenient[int64](data, "overStr"); err == nil {
+		t.Error("GetAsLenient[int64] should reject a string above the maximum")
+	}
+	if _, err := collections.GetAsLenient[int64](data, "edge"); err == nil {
+		t.Error("GetAsLenient[int64] should reject 2^63")
+	}
+	if _, err := collections.GetAsLenient[int64](data, "bigUint"); err == nil {
+		t.Error("GetAsLenient[int64] should reject a uint64 above the maximum")
+	}
+	if n, err := collections.GetAsLenient[uint64](data, "maxUint"); err != nil || n != math.MaxUint64 {
+		t.Errorf("GetAsLenient[uint64] max string failed: %d %v", n, err)
+	}
+	if _, err := collections.GetAsLenient[uint](data, "negative"); err == nil {
+		t.Error("GetAsLenient[uint] should reject negative values")
+	}
+	if _, err := collections.GetAsLenient[int8](data, "maxStr"); err == nil {
+		t.Error("GetAsLenient[int8] should reject out of range values")
+	}
+}
+
+func TestGetAsLenientArrays(t *testing.T) {
+	data := map[string]any{"short": []int{1}, "exact": []any{1, "2", 3.0}}
+	var mismatch *collections.TypeMismatchException
+	if _, err := collections.GetAsLenient[[3]int](data, "short"); !errors.As(err, &mismatch) {
+		t.Errorf("GetAsLenient[[3]int] should reject a shorter slice: %v", err)
+	}
+	if _, err := collections.GetAsLenient[*[3]int](data, "short"); !errors.As(err, &mismatch) {
+		t.Errorf("GetAsLenient[*[3]int] should reject a shorter slice: %v", err)
+	}
+	if arr, err := collections.GetAsLenient[[3]int](data, "exact"); err != nil || arr != [3]int{1, 2, 3} {
+		t.Errorf("GetAsLenient[[3]int] failed: %v %v", arr, err)
+	}
+}
+
+func TestGetAsLenientSecondsRange(t *testing.T) {
+	data := map[string]any{"huge": 1e300, "nan": math.NaN(), "inf": math.Inf(-1), "ok": 1.5}
+	for _, key := range []string{"huge", "nan", "inf"} {
+		if d, err := collections.GetAsLenient[time.Duration](data, key); err == nil {
+			t.Errorf("GetAsLenient[time.Duration] should reject %s, got %v", key, d)
+		}
+		if tm, err := collections.GetAsLenient[time.Time](data, key); err == nil {
+			t.Errorf("GetAsLenient[time.Time] should reject %s, got %v", key, tm)
+		}
+	}
+	if d, err := collections.GetAsLenient[time.Duration](data, "ok"); err != nil || d != 1500*time.Millisecond {
+		t.Errorf("GetAsLenient[time.Duration] failed: %v %v", d, err)
+	}
+}
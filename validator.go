@@ -0,0 +1,522 @@
+package collections
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// ValidationField describes the value a validation rule is applied to.
+type ValidationField struct {
+	Path   string   // Concrete path of the value, such as "items.0.qty"
+	Value  any      // The value, or nil if it is missing
+	Exists bool     // Whether the path exists in the data
+	Params []string // Rule parameters, such as ["1", "10"] for "between:1,10"
+	Data   any      // The whole data being validated
+
+	numeric bool           // The field also has a numeric or integer rule
+	regex   *regexp.Regexp // The compiled pattern of a regex rule
+}
+
+// ValidationRule reports whether a field passes a rule.
+type ValidationRule func(field ValidationField) bool
+
+// ruleDefinition is a registered validation rule and its default message.
+type ruleDefinition struct {
+	rule    ValidationRule
+	message string
+}
+
+// validationRule is one parsed rule of a rule string.
+type validationRule struct {
+	name   string
+	params []string
+	regex  *regexp.Regexp
+}
+
+var (
+	validationRulesMu sync.RWMutex
+	validationRules   = map[string]ruleDefinition{
+		"required": {ruleRequired, "The :attribute field is required."},
+		"nullable": {func(ValidationField) bool { return true }, ""},
+		"string":   {ruleString, "The :attribute field must be a string."},
+		"numeric":  {ruleNumeric, "The :attribute field must be a number."},
+		"integer":  {ruleInteger, "The :attribute field must be an integer."},
+		"array":    {ruleArray, "The :attribute field must be an array."},
+		"in":       {ruleIn, "The selected :attribute is invalid."},
+		"regex":    {ruleRegex, "The :attribute field format is invalid."},
+		"email":    {ruleEmail, "The :attribute field must be a valid email address."},
+		"date":     {ruleDate, "The :attribute field must be a valid date."},
+		"min":      {ruleMin, "The :attribute field must be at least :min."},
+		"max":      {ruleMax, "The :attribute field must not be greater than :max."},
+		"between":  {ruleBetween, "The :attribute field must be between :min and :max."},
+	}
+
+	// sizeMessages are the default messages of the size rules for strings and arrays.
+	sizeMessages = map[string]string{
+		"min.string":     "The :attribute field must be at least :min characters.",
+		"min.array":      "The :attribute field must have at least :min items.",
+		"max.string":     "The :attribute field must not be greater than :max characters.",
+		"max.array":      "The :attribute field must not have more than :max items.",
+		"between.string": "The :attribute field must be between :min and :max characters.",
+		"between.array":  "The :attribute field must have between :min and :max items.",
+	}
+)
+
+// RegisterRule registers a validation rule available to every Validator.
+// The message may use the :attribute placeholder for the field path and :values for the parameters.
+func RegisterRule(name string, rule ValidationRule, message string) {
+	validationRulesMu.Lock()
+	defer validationRulesMu.Unlock()
+	validationRules[name] = ruleDefinition{rule, message}
+}
+
+// Validator validates nested data against rules keyed by dot-notation paths,
+// similar to Laravel's Validator.
+//
+// Rules are written as "required|integer|min:1". Paths may contain "*" wildcards, which
+// are expanded against the data so each element is validated and reported separately.
+// Fields that are missing are only checked by "required", and fields with the "nullable"
+// rule may be nil. A regex rule containing "|" must be the last rule of its string.
+type Validator struct {
+	rules    map[string][]validationRule
+	patterns []string
+	custom   map[string]ruleDefinition
+	messages map[string]string
+}
+
+// NewValidator creates a Validator from rule strings keyed by path.
+// Regex rules are compiled once here, and it panics if one does not compile.
+func NewValidator(rules map[string]string) *Validator {
+	v, err := newValidator(rules)
+	if err != nil {
+		panic("collections: " + err.Error())
+	}
+	return v
+}
+
+// NewValidatorOrFail is NewValidator returning an InvalidArgumentException if a regex rule
+// does not compile or a rule is not registered with RegisterRule. Rules added with Extend
+// are not known yet, so use NewValidator for them.
+func NewValidatorOrFail(rules map[string]string) (*Validator, error) {
+	v, err := newValidator(rules)
+	if err != nil {
+		return nil, err
+	}
+	if err := v.checkRules(); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// newValidator parses the rule strings of a Validator.
+func newValidator(rules map[string]string) (*Validator, error) {
+	v := &Validator{
+		rules:    make(map[string][]validationRule, len(rules)),
+		custom:   make(map[string]ruleDefinition),
+		messages: make(map[string]string),
+	}
+	for pattern, spec := range rules {
+		parsed, err := parseRules(spec)
+		if err != nil {
+			return nil, &InvalidArgumentException{Message: fmt.Sprintf("rules for %q: %v", pattern, err)}
+		}
+		v.rules[pattern] = parsed
+		v.patterns = append(v.patterns, pattern)
+	}
+	sort.Strings(v.patterns)
+	return v, nil
+}
+
+// Extend registers a validation rule available only to this Validator.
+func (v *Validator) Extend(name string, rule ValidationRule, message string) *Validator {
+	v.custom[name] = ruleDefinition{rule, message}
+	return v
+}
+
+// WithMessages overrides failure messages. Keys are either a rule name ("required") or a
+// rule path followed by a rule name ("items.*.qty.min").
+func (v *Validator) WithMessages(messages map[string]string) *Validator {
+	for key, message := range messages {
+		v.messages[key] = message
+	}
+	return v
+}
+
+// Validate validates a map[string]any, *MapCollection[string, any] or other nested data
+// and returns the failure messages keyed by concrete path. The result is empty if the data is valid.
+// It panics if a rule is not registered, whether or not the data contains its path.
+func (v *Validator) Validate(data any) *MapCollection[string, []string] {
+	if err := v.checkRules(); err != nil {
+		panic("collections: " + err.Error())
+	}
+	errs := NewMap[string, []string](nil)
+	for _, pattern := range v.patterns {
+		rules := v.rules[pattern]
+		for _, path := range expandPattern(data, splitPath(pattern), "") {
+			value, exists := pathGet(data, splitPath(path))
+			if messages := v.validateField(data, pattern, path, value, exists, rules); len(messages) > 0 {
+				errs.Put(path, append(errs.Get(path), messages...))
+			}
+		}
+	}
+	return errs
+}
+
+// ValidateOrFail validates the data and returns a ValidationException if it is invalid.
+func (v *Validator) ValidateOrFail(data any) error {
+	if errs := v.Validate(data); errs.Count() > 0 {
+		return &ValidationException{Errors: errs}
+	}
+	return nil
+}
+
+// Passes determines if the data is valid.
+func (v *Validator) Passes(data any) bool {
+	return v.Validate(data).Count() == 0
+}
+
+// validateField applies the rules of a pattern to one concrete path.
+func (v *Validator) validateField(data any, pattern, path string, value any, exists bool, rules []validationRule) []string {
+	required, nullable, numeric := false, false, false
+	for _, r := range rules {
+		switch r.name {
+		case "required":
+			required = true
+		case "nullable":
+			nullable = true
+		case "numeric", "integer":
+			numeric = true
+		}
+	}
+	if !exists && !required {
+		return nil
+	}
+	if exists && value == nil && nullable && !required {
+		return nil
+	}
+
+	var messages []string
+	for _, r := range rules {
+		def := v.definition(r.name)
+		field := ValidationField{Path: path, Value: value, Exists: exists, Params: r.params, Data: data, numeric: numeric, regex: r.regex}
+		if def.rule(field) {
+			continue
+		}
+		messages = append(messages, v.message(pattern, r, def, field))
+		if r.name == "required" {
+			// Other rules cannot meaningfully check a missing value
+			break
+		}
+	}
+	return messages
+}
+
+// checkRules returns an InvalidArgumentException naming the first rule that is not registered.
+func (v *Validator) checkRules() error {
+	for _, pattern := range v.patterns {
+		for _, r := range v.rules[pattern] {
+			if _, ok := v.lookup(r.name); !ok {
+				return &InvalidArgumentException{Message: fmt.Sprintf("unknown validation rule %q for %q", r.name, pattern)}
+			}
+		}
+	}
+	return nil
+}
+
+// lookup finds a rule on the Validator, then in the global registry.
+func (v *Validator) lookup(name string) (ruleDefinition, bool) {
+	if def, ok := v.custom[name]; ok {
+		return def, true
+	}
+	validationRulesMu.RLock()
+	defer validationRulesMu.RUnlock()
+	def, ok := validationRules[name]
+	return def, ok
+}
+
+// definition returns a rule that checkRules has found.
+func (v *Validator) definition(name string) ruleDefinition {
+	def, _ := v.lookup(name)
+	return def
+}
+
+// message resolves and formats the failure message for a rule.
+func (v *Validator) message(pattern string, r validationRule, def ruleDefinition, field ValidationField) string {
+	message, ok := v.messages[pattern+"."+r.name]
+	if !ok {
+		message, ok = v.messages[r.name]
+	}
+	if !ok {
+		message = def.message
+		if _, builtin := sizeMessages[r.name+".string"]; builtin {
+			if _, kind, ok := sizeOf(field); ok && kind != "numeric" {
+				message = sizeMessages[r.name+"."+kind]
+			}
+		}
+	}
+
+	replacements := []string{":attribute", field.Path, ":values", strings.Join(r.params, ", ")}
+	switch r.name {
+	case "min":
+		replacements = append(replacements, ":min", paramAt(r.params, 0))
+	case "max":
+		replacements = append(replacements, ":max", paramAt(r.params, 0))
+	case "between":
+		replacements = append(replacements, ":min", paramAt(r.params, 0), ":max", paramAt(r.params, 1))
+	}
+	return strings.NewReplacer(replacements...).Replace(message)
+}
+
+// parseRules parses a rule string such as "required|in:a,b|regex:^x$", compiling regex rules.
+func parseRules(spec string) ([]validationRule, error) {
+	var result []validationRule
+	parts := strings.Split(spec, "|")
+	for i := 0; i < len(parts); i++ {
+		part := strings.TrimSpace(parts[i])
+		if part == "" {
+			continue
+		}
+		name, arg, hasArg := strings.Cut(part, ":")
+		r := validationRule{name: name}
+		switch {
+		case name == "regex":
+			// The pattern may itself contain "|"
+			r.params = []string{strings.Join(append([]string{arg}, parts[i+1:]...), "|")}
+			i = len(parts)
+			re, err := regexp.Compile(r.params[0])
+			if err != nil {
+				return nil, fmt.Errorf("invalid regex rule: %v", err)
+			}
+			r.regex = re
+		case hasArg:
+			r.params = strings.Split(arg, ",")
+		}
+		result = append(result, r)
+	}
+	return result, nil
+}
+
+// expandPattern expands the wildcards of a rule path into the concrete paths present in the data.
+// Non-wildcard segments are kept even if they are missing so "required" can report them.
+func expandPattern(current any, segments []string, prefix string) []string {
+	if len(segments) == 0 {
+		return []string{prefix}
+	}
+	seg := segments[0]
+	if seg != pathWildcard {
+		next, _ := pathStep(current, seg)
+		return expandPattern(next, segments[1:], joinPath(prefix, seg))
+	}
+
+	keys, ok := pathKeys(current)
+	if !ok {
+		return nil
+	}
+	children, _ := pathChildren(current)
+	var result []string
+	for i, key := range keys {
+		result = append(result, expandPattern(children[i], segments[1:], joinPath(prefix, key))...)
+	}
+	return result
+}
+
+// joinPath appends a segment to a dot-notation path.
+func joinPath(prefix, seg string) string {
+	if prefix == "" {
+		return seg
+	}
+	return prefix + "." + seg
+}
+
+// paramAt returns the i-th rule parameter, or "" if there are too few.
+func paramAt(params []string, i int) string {
+	if i < len(params) {
+		return params[i]
+	}
+	return ""
+}
+
+// isEmptyValue determines if a value counts as missing for the required rule.
+func isEmptyValue(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(v) == ""
+	}
+	rv, ok := indirect(reflect.ValueOf(value))
+	if !ok {
+		return true
+	}
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return rv.Len() == 0
+	}
+	if node, ok := value.(pathNode); ok {
+		return len(node.pathKeys()) == 0
+	}
+	return false
+}
+
+// isNumeric determines if a value is a number or a numeric string.
+func isNumeric(value any) bool {
+	switch v := value.(type) {
+	case string:
+		_, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return err == nil
+	case json.Number:
+		_, err := v.Float64()
+		return err == nil
+	case nil:
+		return false
+	}
+	return isNumericKind(reflect.ValueOf(value).Kind())
+}
+
+// sizeOf returns what the size rules compare: the number itself for numeric values,
+// the character count for strings and the element count for arrays.
+func sizeOf(field ValidationField) (float64, string, bool) {
+	value := field.Value
+	if s, ok := value.(string); ok {
+		if field.numeric && isNumeric(s) {
+			f, _ := coerceNumber(s)
+			return f, "numeric", true
+		}
+		return float64(utf8.RuneCountInString(s)), "string", true
+	}
+	if isNumeric(value) {
+		f, _ := coerceNumber(value)
+		return f, "numeric", true
+	}
+	if ruleArray(field) {
+		keys, _ := pathKeys(value)
+		return float64(len(keys)), "array", true
+	}
+	return 0, "", false
+}
+
+// sizeParam parses a numeric rule parameter.
+func sizeParam(params []string, i int) (float64, bool) {
+	f, err := strconv.ParseFloat(paramAt(params, i), 64)
+	return f, err == nil
+}
+
+func ruleRequired(field ValidationField) bool {
+	return field.Exists && !isEmptyValue(field.Value)
+}
+
+func ruleString(field ValidationField) bool {
+	_, ok := field.Value.(string)
+	return ok
+}
+
+func ruleNumeric(field ValidationField) bool {
+	return isNumeric(field.Value)
+}
+
+func ruleInteger(field ValidationField) bool {
+	if s, ok := field.Value.(string); ok {
+		_, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+		return err == nil
+	}
+	if !isNumeric(field.Value) {
+		return false
+	}
+	f, err := coerceNumber(field.Value)
+	return err == nil && f == float64(int64(f))
+}
+
+func ruleArray(field ValidationField) bool {
+	if node, ok := field.Value.(pathNode); ok {
+		return !reflect.ValueOf(node).IsNil()
+	}
+	rv, ok := indirect(reflect.ValueOf(field.Value))
+	if !ok {
+		return false
+	}
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return true
+	}
+	return false
+}
+
+func ruleIn(field ValidationField) bool {
+	s, err := coerceString(field.Value)
+	if err != nil {
+		return false
+	}
+	for _, allowed := range field.Params {
+		if s == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+func ruleRegex(field ValidationField) bool {
+	s, err := coerceString(field.Value)
+	if err != nil {
+		return false
+	}
+	re := field.regex
+	if re == nil {
+		if re, err = regexp.Compile(paramAt(field.Params, 0)); err != nil {
+			return false
+		}
+	}
+	return re.MatchString(s)
+}
+
+func ruleEmail(field ValidationField) bool {
+	s, ok := field.Value.(string)
+	if !ok {
+		return false
+	}
+	addr, err := mail.ParseAddress(s)
+	return err == nil && addr.Address == s
+}
+
+func ruleDate(field ValidationField) bool {
+	if _, ok := field.Value.(time.Time); ok {
+		return true
+	}
+	s, ok := field.Value.(string)
+	if !ok {
+		return false
+	}
+	for _, layout := range timeLayouts {
+		if _, err := time.Parse(layout, strings.TrimSpace(s)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+func ruleMin(field ValidationField) bool {
+	size, _, ok := sizeOf(field)
+	limit, valid := sizeParam(field.Params, 0)
+	return ok && valid && size >= limit
+}
+
+func ruleMax(field ValidationField) bool {
+	size, _, ok := sizeOf(field)
+	limit, valid := sizeParam(field.Params, 0)
+	return ok && valid && size <= limit
+}
+
+func ruleBetween(field ValidationField) bool {
+	size, _, ok := sizeOf(field)
+	low, lowValid := sizeParam(field.Params, 0)
+	high, highValid := sizeParam(field.Params, 1)
+	return ok && lowValid && highValid && size >= low && size <= high
+}
@@ -0,0 +1,193 @@
+package collections_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/qiuapeng921/collections"
+)
+
+func TestValidatorValidate(t *testing.T) {
+	v := collections.NewValidator(map[string]string{
+		"email":       "required|email",
+		"items":       "required|array|min:1",
+		"items.*.sku": "required|string",
+		"items.*.qty": "required|integer|min:1",
+	})
+	errs := v.Validate(map[string]any{
+		"email": "jane@example.com",
+		"items": []any{
+			map[string]any{"sku": "A1", "qty": 2.0},
+			map[string]any{"sku": "B2", "qty": 0.0},
+			map[string]any{"sku": ""},
+		},
+	})
+
+	expected := map[string][]string{
+		"items.1.qty": {"The items.1.qty field must be at least 1."},
+		"items.2.qty": {"The items.2.qty field is required."},
+		"items.2.sku": {"The items.2.sku field is required."},
+	}
+	if !reflect.DeepEqual(errs.All(), expected) {
+		t.Errorf("Unexpected errors: %v", errs.All())
+	}
+	if !reflect.DeepEqual(errs.Keys().All(), []string{"items.1.qty", "items.2.qty", "items.2.sku"}) {
+		t.Errorf("Unexpected error order: %v", errs.Keys())
+	}
+}
+
+func TestValidatorSizeRules(t *testing.T) {
+	v := collections.NewValidator(map[string]string{
+		"name": "string|min:3",
+		"age":  "numeric|between:18,99",
+		"tags": "array|max:2",
+	})
+	errs := v.Validate(map[string]any{"name": "Al", "age": "17", "tags": []any{"a", "b", "c"}})
+	expected := map[string][]string{
+		"name": {"The name field must be at least 3 characters."},
+		"age":  {"The age field must be between 18 and 99."},
+		"tags": {"The tags field must not have more than 2 items."},
+	}
+	if !reflect.DeepEqual(errs.All(), expected) {
+		t.Errorf("Unexpected errors: %v", errs.All())
+	}
+}
+
+func TestValidatorFormatRules(t *testing.T) {
+	v := collections.NewValidator(map[string]string{
+		"email": "email",
+		"role":  "in:admin,user",
+		"when":  "date",
+		"code":  "regex:^(a|b)$",
+	})
+	errs := v.Validate(map[string]any{
+		"email": "Jane <jane@example.com>",
+		"role":  "guest",
+		"when":  "yesterday",
+		"code":  "c",
+	})
+	expected := map[string][]string{
+		"email": {"The email field must be a valid email address."},
+		"role":  {"The selected role is invalid."},
+		"when":  {"The when field must be a valid date."},
+		"code":  {"The code field format is invalid."},
+	}
+	if !reflect.DeepEqual(errs.All(), expected) {
+		t.Errorf("Unexpected errors: %v", errs.All())
+	}
+}
+
+func TestValidatorOptionalFields(t *testing.T) {
+	v := collections.NewValidator(map[string]string{"name": "string|min:3", "note": "nullable|string"})
+	if !v.Passes(map[string]any{}) {
+		t.Error("Optional missing fields should pass")
+	}
+	if !v.Passes(map[string]any{"note": nil}) {
+		t.Error("Nil should pass a nullable field")
+	}
+	if v.Passes(map[string]any{"name": nil}) {
+		t.Error("Nil should fail string without nullable")
+	}
+}
+
+func TestValidatorMapCollection(t *testing.T) {
+	m := collections.NewMap(map[string]any{
+		"items": collections.New([]any{
+			collections.NewMap(map[string]any{"qty": 3}),
+			collections.NewMap(map[string]any{"qty": "x"}),
+		}),
+	})
+	errs := collections.NewValidator(map[string]string{"items.*.qty": "integer"}).Validate(m)
+	if !reflect.DeepEqual(errs.Keys().All(), []string{"items.1.qty"}) {
+		t.Errorf("Unexpected errors: %v", errs.All())
+	}
+}
+
+func TestValidatorCustomRulesAndMessages(t *testing.T) {
+	collections.RegisterRule("uppercase", func(f collections.ValidationField) bool {
+		s, ok := f.Value.(string)
+		return ok && s == strings.ToUpper(s)
+	}, "The :attribute field must be uppercase.")
+
+	v := collections.NewValidator(map[string]string{
+		"code":  "uppercase",
+		"sku":   "starts_with:SKU-",
+		"title": "required",
+	}).Extend("starts_with", func(f collections.ValidationField) bool {
+		s, _ := f.Value.(string)
+		return strings.HasPrefix(s, f.Params[0])
+	}, "The :attribute field must start with :values.").WithMessages(map[string]string{
+		"title.required": "Please give it a title.",
+	})
+
+	errs := v.Validate(map[string]any{"code": "abc", "sku": "X-1"})
+	expected := map[string][]string{
+		"code":  {"The code field must be uppercase."},
+		"sku":   {"The sku field must start with SKU-."},
+		"title": {"Please give it a title."},
+	}
+	if !reflect.DeepEqual(errs.All(), expected) {
+		t.Errorf("Unexpected errors: %v", errs.All())
+	}
+}
+
+func TestValidatorValidateOrFail(t *testing.T) {
+	v := collections.NewValidator(map[string]string{
+		"status":      "required|in:pending,paid",
+		"items.*.qty": "required|integer|min:1",
+	})
+	err := v.ValidateOrFail(map[string]any{
+		"items": []any{map[string]any{"qty": 0}, map[string]any{"qty": "x"}},
+	})
+	var validation *collections.ValidationException
+	if !errors.As(err, &validation) {
+		t.Fatalf("Expected ValidationException, got %v", err)
+	}
+	if validation.Errors.Count() != 3 {
+		t.Errorf("Expected 3 invalid fields, got %d", validation.Errors.Count())
+	}
+	if err.Error() != "The items.0.qty field must be at least 1. (and 2 more invalid fields)" {
+		t.Errorf("Unexpected message: %s", err.Error())
+	}
+}
+
+func TestValidatorValidateOrFailValid(t *testing.T) {
+	v := collections.NewValidator(map[string]string{"status": "required|in:pending,paid"})
+	if err := v.ValidateOrFail(map[string]any{"status": "paid"}); err != nil {
+		t.Errorf("Expected valid data, got %v", err)
+	}
+}
+
+func TestValidatorUnknownRulePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for unknown rule without matching data")
+		}
+	}()
+	collections.NewValidator(map[string]string{"items.*.qty": "intger"}).Validate(map[string]any{})
+}
+
+func TestValidatorInvalidRegexPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for invalid regex")
+		}
+	}()
+	collections.NewValidator(map[string]string{"code": "regex:^(a"})
+}
+
+func TestNewValidatorOrFail(t *testing.T) {
+	var invalid *collections.InvalidArgumentException
+	if _, err := collections.NewValidatorOrFail(map[string]string{"items.*.qty": "required|intger"}); !errors.As(err, &invalid) {
+		t.Errorf("Expected InvalidArgumentException for unknown rule, got %v", err)
+	}
+	if _, err := collections.NewValidatorOrFail(map[string]string{"code": "regex:[z-a]"}); !errors.As(err, &invalid) {
+		t.Errorf("Expected InvalidArgumentException for invalid regex, got %v", err)
+	}
+	v, err := collections.NewValidatorOrFail(map[string]string{"code": "required|regex:^[A-Z]+$"})
+	if err != nil || !v.Passes(map[string]any{"code": "ABC"}) || v.Passes(map[string]any{"code": "abc"}) {
+		t.Errorf("NewValidatorOrFail failed: %v", err)
+	}
+}